@@ -0,0 +1,428 @@
+// Command tccalc converts and calculates timecodes.
+//
+// Usage:
+//
+//	tccalc [flags] <command> [args...]
+//
+// Commands:
+//
+//	to-frames TC...        timecode to number of frames
+//	from-frames N...       number of frames to timecode
+//	to-duration TC...      timecode to real time seconds
+//	from-duration D...     real time ("3.5" seconds or "1h2m3.5s") to timecode, rounded to the nearest frame
+//	to-samples TC...       timecode to number of audio samples at -sample-rate
+//	from-samples N...      number of audio samples at -sample-rate to timecode
+//	convert -to RATE TC... timecode to another frame rate
+//	calc EXPR              evaluate expression, e.g. "01:00:00:00 + 00:00:10:12 - 48f"
+//	validate TC...         validate timecodes strictly, rejecting dropped frames
+//
+// validate also rejects the last separator contradicting DF of -rate, e.g. 01:00:00:00 at 29.97DF,
+// unless -detect-df=false is given after validate.
+//
+// Without args, inputs are read from stdin, one per line.
+// Timecodes are accepted in the flexible forms of timecode.ParseTimecodeFlexible.
+//
+// Exit codes:
+//
+//	0 success
+//	1 other error
+//	2 usage error
+//	3 invalid timecode
+//	4 too many frames
+//	5 underflow frames
+//	6 unsupported or invalid frame rate
+//	7 mismatch frame rate
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abema/go-timecode/timecode"
+	"github.com/abema/go-timecode/timecode/expr"
+)
+
+// exit codes
+const (
+	exitOK = iota
+	exitError
+	exitUsage
+	exitInvalidTimecode
+	exitTooManyFrames
+	exitUnderflowFrames
+	exitUnsupportedFrameRate
+	exitMismatchFrameRate
+)
+
+// result represents result of an input.
+type result struct {
+	Input    string   `json:"input"`
+	Timecode string   `json:"timecode,omitempty"`
+	Rate     string   `json:"rate,omitempty"`
+	Frames   *int64   `json:"frames,omitempty"`
+	Seconds  *float64 `json:"seconds,omitempty"`
+	Samples  *int64   `json:"samples,omitempty"`
+	Error    string   `json:"error,omitempty"`
+
+	value string // plain output
+}
+
+// config represents command line flags.
+type config struct {
+	rate       timecode.FrameRate
+	sampleRate int64
+	json       bool
+	signed     bool
+	extended   bool
+	opts       []timecode.ParseTimecodeOption
+	stdout     io.Writer
+	stderr     io.Writer
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs tccalc and returns exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("tccalc", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: tccalc [flags] <to-frames|from-frames|to-duration|from-duration|to-samples|from-samples|convert|calc|validate> [args...]")
+		fs.PrintDefaults()
+	}
+	rate := fs.String("rate", "29.97DF", "frame rate, e.g. 25, 29.97NDF, 30000/1001")
+	sampleRate := fs.Int64("sample-rate", 48000, "audio sample rate of to-samples and from-samples")
+	jsonOutput := fs.Bool("json", false, "output JSON lines")
+	signed := fs.Bool("signed", false, "allow negative timecode")
+	extended := fs.Bool("extended", false, "allow 24 hours and more")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	fr, err := timecode.ParseFrameRate(*rate)
+	if err != nil {
+		fmt.Fprintln(stderr, "tccalc:", err)
+		return exitCode(err)
+	}
+	if *sampleRate <= 0 {
+		fmt.Fprintln(stderr, "tccalc: invalid sample rate")
+		return exitUsage
+	}
+	c := &config{
+		rate:       fr,
+		sampleRate: *sampleRate,
+		json:       *jsonOutput,
+		signed:     *signed,
+		extended:   *extended,
+		stdout:     stdout,
+		stderr:     stderr,
+	}
+	c.opts = []timecode.ParseTimecodeOption{func(p *timecode.ParseTimecodeOptionParam) {
+		p.Signed = c.signed
+		p.Extended = c.extended
+	}}
+
+	cmd, cmdArgs := fs.Arg(0), fs.Args()[1:]
+	var eval func(string) (result, error)
+	switch cmd {
+	case "to-frames":
+		eval = c.toFrames
+	case "from-frames":
+		eval = c.fromFrames
+	case "to-duration":
+		eval = c.toDuration
+	case "from-duration":
+		eval = c.fromDuration
+	case "to-samples":
+		eval = c.toSamples
+	case "from-samples":
+		eval = c.fromSamples
+	case "convert":
+		cfs := flag.NewFlagSet("convert", flag.ContinueOnError)
+		cfs.SetOutput(stderr)
+		to := cfs.String("to", "", "frame rate to convert to")
+		strategy := cfs.String("strategy", "realtime", "realtime, label or frames")
+		if err := cfs.Parse(cmdArgs); err != nil {
+			return exitUsage
+		}
+		if *to == "" {
+			fmt.Fprintln(stderr, "tccalc: convert requires -to")
+			return exitUsage
+		}
+		toRate, err := timecode.ParseFrameRate(*to)
+		if err != nil {
+			fmt.Fprintln(stderr, "tccalc:", err)
+			return exitCode(err)
+		}
+		s, ok := map[string]timecode.ConvertStrategy{
+			"realtime": timecode.PreserveRealTime,
+			"label":    timecode.PreserveLabel,
+			"frames":   timecode.PreserveFrames,
+		}[*strategy]
+		if !ok {
+			fmt.Fprintln(stderr, "tccalc: invalid strategy", *strategy)
+			return exitUsage
+		}
+		eval = c.convert(toRate, s)
+		cmdArgs = cfs.Args()
+	case "calc":
+		eval = c.calc
+		if len(cmdArgs) != 0 {
+			cmdArgs = []string{strings.Join(cmdArgs, " ")}
+		}
+	case "validate":
+		vfs := flag.NewFlagSet("validate", flag.ContinueOnError)
+		vfs.SetOutput(stderr)
+		detectDF := vfs.Bool("detect-df", true, "reject last separator contradicting DF of -rate")
+		if err := vfs.Parse(cmdArgs); err != nil {
+			return exitUsage
+		}
+		eval = c.validate(*detectDF)
+		cmdArgs = vfs.Args()
+	default:
+		fmt.Fprintln(stderr, "tccalc: unknown command", cmd)
+		fs.Usage()
+		return exitUsage
+	}
+
+	inputs := cmdArgs
+	if len(inputs) == 0 {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				inputs = append(inputs, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintln(stderr, "tccalc:", err)
+			return exitError
+		}
+	}
+
+	code := exitOK
+	for _, input := range inputs {
+		res, err := eval(input)
+		res.Input = input
+		if err != nil {
+			res.Error = err.Error()
+			if code == exitOK {
+				code = exitCode(err)
+			}
+		}
+		c.print(res, err)
+	}
+	return code
+}
+
+// print prints result.
+func (c *config) print(res result, err error) {
+	if c.json {
+		b, _ := json.Marshal(res)
+		fmt.Fprintln(c.stdout, string(b))
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(c.stderr, "tccalc: %s: %v\n", res.Input, err)
+		return
+	}
+	fmt.Fprintln(c.stdout, res.value)
+}
+
+// exitCode returns exit code of err.
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, timecode.ErrMismatchFrameRate):
+		return exitMismatchFrameRate
+	case errors.Is(err, timecode.ErrUnderflowFrames):
+		return exitUnderflowFrames
+	case errors.Is(err, timecode.ErrTooManyFrames):
+		return exitTooManyFrames
+	case errors.Is(err, timecode.ErrUnsupportedFrameRate), errors.Is(err, timecode.ErrInvalidFrameRate):
+		return exitUnsupportedFrameRate
+	case errors.Is(err, timecode.ErrInvalidTimecode):
+		return exitInvalidTimecode
+	default:
+		return exitError
+	}
+}
+
+// newResult returns result of Timecode.
+func newResult(tc *timecode.Timecode) result {
+	frames := tc.SignedFrames()
+	seconds := tc.Duration().Seconds()
+	return result{
+		Timecode: tc.String(),
+		Rate:     tc.FrameRate().String(),
+		Frames:   &frames,
+		Seconds:  &seconds,
+		value:    tc.String(),
+	}
+}
+
+// parse returns Timecode from input.
+func (c *config) parse(s string) (*timecode.Timecode, error) {
+	return timecode.ParseTimecodeFlexibleWithRate(s, c.rate, c.opts...)
+}
+
+// timecodeOption applies signed and extended flags to TimecodeOptionParam.
+func (c *config) timecodeOption(p *timecode.TimecodeOptionParam) {
+	p.Signed = c.signed
+	p.Extended = c.extended
+}
+
+// fromFramesCount returns Timecode from signed number of frames.
+func (c *config) fromFramesCount(frames int64) (*timecode.Timecode, error) {
+	zero, err := timecode.NewTimecodeWithRate(0, c.rate, c.timecodeOption)
+	if err != nil {
+		return nil, err
+	}
+	if frames < 0 {
+		return zero.SubFrames(uint64(-frames))
+	}
+	return zero.AddFrames(uint64(frames))
+}
+
+func (c *config) toFrames(s string) (result, error) {
+	tc, err := c.parse(s)
+	if err != nil {
+		return result{}, err
+	}
+	res := newResult(tc)
+	res.value = strconv.FormatInt(*res.Frames, 10)
+	return res, nil
+}
+
+func (c *config) fromFrames(s string) (result, error) {
+	frames, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return result{}, fmt.Errorf("invalid frames: %w", timecode.ErrInvalidTimecode)
+	}
+	tc, err := c.fromFramesCount(frames)
+	if err != nil {
+		return result{}, err
+	}
+	return newResult(tc), nil
+}
+
+func (c *config) toDuration(s string) (result, error) {
+	tc, err := c.parse(s)
+	if err != nil {
+		return result{}, err
+	}
+	res := newResult(tc)
+	res.value = strconv.FormatFloat(*res.Seconds, 'f', -1, 64)
+	return res, nil
+}
+
+func (c *config) fromDuration(s string) (result, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		sec, ferr := strconv.ParseFloat(s, 64)
+		if ferr != nil || math.Abs(sec) > math.MaxInt64/float64(time.Second) {
+			return result{}, fmt.Errorf("invalid duration: %w", timecode.ErrInvalidTimecode)
+		}
+		d = time.Duration(sec * float64(time.Second))
+	}
+	tc, err := timecode.FromDurationWithRate(d, c.rate, c.timecodeOption, func(p *timecode.TimecodeOptionParam) {
+		p.Rounding = timecode.RoundNearest
+	})
+	if err != nil {
+		return result{}, err
+	}
+	return newResult(tc), nil
+}
+
+func (c *config) toSamples(s string) (result, error) {
+	tc, err := c.parse(s)
+	if err != nil {
+		return result{}, err
+	}
+	// samples = frames * den * sampleRate / num, rounded down to the sample of the frame start
+	fr := tc.FrameRate()
+	n := new(big.Int).SetInt64(tc.SignedFrames())
+	n.Mul(n, big.NewInt(int64(fr.Denominator)*c.sampleRate))
+	q := new(big.Int).Div(n, big.NewInt(int64(fr.Numerator)))
+	if !q.IsInt64() {
+		return result{}, timecode.ErrTooManyFrames
+	}
+	samples := q.Int64()
+	res := newResult(tc)
+	res.Samples = &samples
+	res.value = strconv.FormatInt(samples, 10)
+	return res, nil
+}
+
+func (c *config) fromSamples(s string) (result, error) {
+	samples, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return result{}, fmt.Errorf("invalid samples: %w", timecode.ErrInvalidTimecode)
+	}
+	// frames = samples * num / (den * sampleRate), rounded down to the frame containing the sample
+	n := new(big.Int).SetInt64(samples)
+	n.Mul(n, big.NewInt(int64(c.rate.Numerator)))
+	q := new(big.Int).Div(n, big.NewInt(int64(c.rate.Denominator)*c.sampleRate))
+	if !q.IsInt64() {
+		return result{}, timecode.ErrTooManyFrames
+	}
+	tc, err := c.fromFramesCount(q.Int64())
+	if err != nil {
+		return result{}, err
+	}
+	res := newResult(tc)
+	res.Samples = &samples
+	return res, nil
+}
+
+func (c *config) convert(fr timecode.FrameRate, strategy timecode.ConvertStrategy) func(string) (result, error) {
+	return func(s string) (result, error) {
+		tc, err := c.parse(s)
+		if err != nil {
+			return result{}, err
+		}
+		conv, err := tc.ConvertRate(fr, func(p *timecode.ConvertRateOptionParam) {
+			p.Strategy = strategy
+		})
+		if err != nil {
+			return result{}, err
+		}
+		return newResult(conv), nil
+	}
+}
+
+func (c *config) calc(s string) (result, error) {
+	tc, err := expr.EvalWithRate(s, c.rate, c.opts...)
+	if err != nil {
+		return result{}, err
+	}
+	return newResult(tc), nil
+}
+
+func (c *config) validate(detectDF bool) func(string) (result, error) {
+	return func(s string) (result, error) {
+		tc, err := timecode.ParseTimecodeWithRate(s, c.rate, append(c.opts, func(p *timecode.ParseTimecodeOptionParam) {
+			p.Mode = timecode.ParseStrict
+			p.DetectDF = detectDF
+		})...)
+		if err != nil {
+			return result{}, err
+		}
+		res := newResult(tc)
+		res.value = "OK " + tc.String()
+		return res, nil
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		args   []string
+		stdin  string
+		code   int
+		stdout string
+	}{
+		{"to-frames", []string{"to-frames", "01:00:00;00"}, "", exitOK, "107892\n"},
+		{"to-frames/rate", []string{"-rate", "25", "to-frames", "01:00:00:00", "10:00"}, "", exitOK, "90000\n250\n"},
+		{"to-frames/stdin", []string{"-rate", "25", "to-frames"}, "01:00:00:00\n\n00:00:01:00\n", exitOK, "90000\n25\n"},
+		{"from-frames", []string{"from-frames", "107892"}, "", exitOK, "01:00:00:00\n"},
+		{"from-frames/signed", []string{"-rate", "25", "-signed", "from-frames", "-25"}, "", exitOK, "-00:00:01:00\n"},
+		{"to-duration", []string{"-rate", "30000/1001", "to-duration", "00:00:01:00"}, "", exitOK, "1.001\n"},
+		{"from-duration", []string{"-rate", "25", "from-duration", "1.5", "1h0m0.02s"}, "", exitOK, "00:00:01:13\n01:00:00:01\n"},
+		{"to-samples", []string{"-rate", "29.97NDF", "to-samples", "00:00:00:01"}, "", exitOK, "1601\n"},
+		{"to-samples/sample-rate", []string{"-rate", "25", "-sample-rate", "96000", "to-samples", "00:00:01:00"}, "", exitOK, "96000\n"},
+		{"from-samples", []string{"-rate", "25", "from-samples", "48000", "49919", "49920"}, "", exitOK, "00:00:01:00\n00:00:01:00\n00:00:01:01\n"},
+		{"convert", []string{"-rate", "25", "convert", "-to", "29.97DF", "10:00:00:00"}, "", exitOK, "10:00:00:01\n"},
+		{"convert/strategy", []string{"-rate", "25", "convert", "-to", "30", "-strategy", "label", "10:00:00:00"}, "", exitOK, "10:00:00:00\n"},
+		{"calc", []string{"-rate", "24", "calc", "01:00:00:00", "+", "00:00:10:12", "-", "48f"}, "", exitOK, "01:00:08:12\n"},
+		{"calc/stdin", []string{"-rate", "24", "calc"}, "1:00 * 2\n48f / 2\n", exitOK, "00:00:02:00\n00:00:01:00\n"},
+		{"validate", []string{"validate"}, "01:00:00;00\n00:10:00;00\n", exitOK, "OK 01:00:00;00\nOK 00:10:00;00\n"},
+		{"json", []string{"-json", "-rate", "25", "to-frames", "00:00:01:00"}, "", exitOK,
+			`{"input":"00:00:01:00","timecode":"00:00:01:00","rate":"25","frames":25,"seconds":1}` + "\n"},
+		{"json/error", []string{"-json", "-rate", "25", "to-frames", "xx"}, "", exitInvalidTimecode,
+			`{"input":"xx","error":"parse timecode \"xx\": timecode has invalid character 'x' at offset 0"}` + "\n"},
+
+		{"error/invalid timecode", []string{"to-frames", "01:60:00:00"}, "", exitInvalidTimecode, ""},
+		{"error/dropped frame", []string{"validate"}, "01:00:00;00\n00:01:00;00\n", exitInvalidTimecode, "OK 01:00:00;00\n"},
+		{"error/mismatch frame rate", []string{"validate"}, "01:00:00:00\n", exitMismatchFrameRate, ""},
+		{"validate/args", []string{"validate", "01:00:00;00", "00:10:00;00"}, "", exitOK, "OK 01:00:00;00\nOK 00:10:00;00\n"},
+		{"validate/detect-df=false", []string{"validate", "-detect-df=false", "01:00:00:00"}, "", exitOK, "OK 01:00:00:00\n"},
+		{"error/validate args", []string{"validate", "01:00:00;00", "00:01:00;00"}, "", exitInvalidTimecode, "OK 01:00:00;00\n"},
+		{"error/too many frames", []string{"-rate", "25", "from-frames", "2160000"}, "", exitTooManyFrames, ""},
+		{"error/underflow frames", []string{"-rate", "25", "calc", "00:00:01:00 - 26f"}, "", exitUnderflowFrames, ""},
+		{"error/division by zero", []string{"-rate", "25", "calc", "00:00:01:00 / 0"}, "", exitInvalidTimecode, ""},
+		{"error/unsupported frame rate", []string{"-rate", "1", "to-frames", "00:00:00:00"}, "", exitUnsupportedFrameRate, ""},
+		{"error/first error wins", []string{"-rate", "25", "from-frames", "-1", "xx", "1"}, "", exitUnderflowFrames, "00:00:00:01\n"},
+		{"usage/no command", []string{}, "", exitUsage, ""},
+		{"usage/unknown command", []string{"foo"}, "", exitUsage, ""},
+		{"usage/unknown flag", []string{"-foo", "to-frames"}, "", exitUsage, ""},
+		{"usage/convert without -to", []string{"convert", "01:00:00:00"}, "", exitUsage, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			assert.Equal(t, tt.code, code, stderr.String())
+			assert.Equal(t, tt.stdout, stdout.String())
+			if tt.code != exitOK && !strings.HasPrefix(tt.name, "json") {
+				assert.NotEmpty(t, stderr.String())
+			}
+		})
+	}
+}
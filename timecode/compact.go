@@ -0,0 +1,164 @@
+package timecode
+
+import (
+	"strconv"
+	"time"
+)
+
+// Compact represents timecode as a value of frame count and frame rate.
+// Unlike Timecode, it is passed by value, holds no pointer and its operations do not allocate.
+// It covers 24 hours and is formatted with ":" separators like Timecode created with default options.
+// The zero value is not a valid Compact, and its operations return ErrUnsupportedFrameRate.
+type Compact struct {
+	frames uint64
+	id     uint16 // id of rate
+}
+
+// NewCompact returns new Compact.
+// PreferDF and Tolerance options are used, and the others are ignored.
+func NewCompact(frames uint64, num, den int32, opts ...TimecodeOption) (Compact, error) {
+	p := newTimecodeOptionParam()
+	p.applyTimecodeOption(opts...)
+
+	r, err := newApproxRate(num, den, p.PreferDF, p.Tolerance)
+	if err != nil {
+		return Compact{}, err
+	}
+	return newCompact(frames, r)
+}
+
+// NewCompactWithRate returns new Compact with FrameRate.
+func NewCompactWithRate(frames uint64, fr FrameRate) (Compact, error) {
+	r, err := fr.rate()
+	if err != nil {
+		return Compact{}, err
+	}
+	return newCompact(frames, r)
+}
+
+// newCompact returns new Compact from rate.
+func newCompact(frames uint64, r *rate) (Compact, error) {
+	if !r.isRepresentableFrames(frames, false) {
+		return Compact{}, ErrTooManyFrames
+	}
+	return Compact{frames: frames, id: r.id}, nil
+}
+
+// Compact returns Compact of Timecode.
+func (tc *Timecode) Compact() (Compact, error) {
+	if tc.Negative {
+		return Compact{}, ErrUnderflowFrames
+	}
+	return newCompact(tc.Frames(), tc.r)
+}
+
+// rate returns rate of Compact.
+func (c Compact) rate() (*rate, error) {
+	return rateByID(c.id)
+}
+
+// Timecode returns Timecode of Compact, or nil for the zero value.
+func (c Compact) Timecode() *Timecode {
+	r, err := c.rate()
+	if err != nil {
+		return nil
+	}
+	p := newTimecodeOptionParam()
+	p.PreferDF = r.dropFrames != 0
+	tc, _ := newTimecode(c.frames, r, p)
+	return tc
+}
+
+// Frames returns number of frames.
+func (c Compact) Frames() uint64 {
+	return c.frames
+}
+
+// FrameRate returns frame rate, or zero FrameRate for the zero value.
+func (c Compact) FrameRate() FrameRate {
+	r, err := c.rate()
+	if err != nil {
+		return FrameRate{}
+	}
+	return r.frameRate()
+}
+
+// Duration returns duration from zero-origin.
+func (c Compact) Duration() time.Duration {
+	r, err := c.rate()
+	if err != nil {
+		return 0
+	}
+	return r.duration(c.frames)
+}
+
+// Add Compact and Compact and return new Compact.
+func (c Compact) Add(other Compact) (Compact, error) {
+	if c.id != other.id {
+		return Compact{}, ErrMismatchFrameRate
+	}
+	return c.AddFrames(other.frames)
+}
+
+// Sub Compact and Compact and return new Compact.
+func (c Compact) Sub(other Compact) (Compact, error) {
+	if c.id != other.id {
+		return Compact{}, ErrMismatchFrameRate
+	}
+	return c.SubFrames(other.frames)
+}
+
+// Add Compact and frames and return new Compact.
+func (c Compact) AddFrames(frames uint64) (Compact, error) {
+	r, err := c.rate()
+	if err != nil {
+		return Compact{}, err
+	}
+	if frames >= r.framesPerDay() {
+		return Compact{}, ErrTooManyFrames
+	}
+	return newCompact(c.frames+frames, r)
+}
+
+// Sub Compact and frames and return new Compact.
+func (c Compact) SubFrames(frames uint64) (Compact, error) {
+	r, err := c.rate()
+	if err != nil {
+		return Compact{}, err
+	}
+	if c.frames < frames {
+		return Compact{}, ErrUnderflowFrames
+	}
+	return newCompact(c.frames-frames, r)
+}
+
+// String returns Compact formatted string.
+// e.g. 01:23:45:28
+func (c Compact) String() string {
+	return string(c.AppendFormat(make([]byte, 0, 12)))
+}
+
+// AppendFormat appends Compact formatted string to b and returns the extended buffer.
+// The zero value is formatted as "<nil>".
+func (c Compact) AppendFormat(b []byte) []byte {
+	r, err := c.rate()
+	if err != nil {
+		return append(b, "<nil>"...)
+	}
+	hh, mm, ss, ff := r.label(c.frames)
+	b = appendPadded(b, hh)
+	b = append(b, ':')
+	b = appendPadded(b, mm)
+	b = append(b, ':')
+	b = appendPadded(b, ss)
+	b = append(b, ':')
+	return appendPadded(b, ff)
+}
+
+// appendPadded appends v padded to 2 digits to b.
+func appendPadded(b []byte, v uint64) []byte {
+	if v < 10 {
+		b = append(b, '0')
+	}
+	return strconv.AppendUint(b, v, 10)
+}
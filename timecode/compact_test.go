@@ -0,0 +1,181 @@
+package timecode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompact(t *testing.T) {
+	t.Run("NewCompact", func(t *testing.T) {
+		c, err := NewCompact(1800, 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1800), c.Frames())
+		assert.Equal(t, "00:01:00:02", c.String())
+		assert.Equal(t, Rate29_97DF, c.FrameRate())
+
+		c, err = NewCompact(1800, 30000, 1001, func(p *TimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", c.String())
+
+		c, err = NewCompactWithRate(7199, Rate120)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59:119", c.String())
+	})
+	t.Run("same as Timecode", func(t *testing.T) {
+		for _, fr := range []FrameRate{Rate23_976, Rate25, Rate29_97DF, Rate59_94DF, Rate59_94NDF} {
+			for frames := uint64(0); frames < 2000000; frames += 7919 {
+				tc, _ := NewTimecodeWithRate(frames, fr)
+				c, err := NewCompactWithRate(frames, fr)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.String(), c.String())
+				assert.Equal(t, tc.Duration(), c.Duration())
+				assert.Equal(t, *tc, *c.Timecode())
+
+				c2, err := tc.Compact()
+				assert.NoError(t, err)
+				assert.Equal(t, c, c2)
+			}
+		}
+	})
+	t.Run("arithmetic", func(t *testing.T) {
+		c1, _ := NewCompact(1798, 30000, 1001)
+		c2, _ := NewCompact(2, 30000, 1001)
+		c3, err := c1.Add(c2)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:02", c3.String())
+
+		c4, err := c3.Sub(c2)
+		assert.NoError(t, err)
+		assert.Equal(t, c1, c4)
+
+		c5, err := c1.AddFrames(2)
+		assert.NoError(t, err)
+		assert.Equal(t, c3, c5)
+
+		c6, err := c5.SubFrames(2)
+		assert.NoError(t, err)
+		assert.Equal(t, c1, c6)
+	})
+	t.Run("AppendFormat", func(t *testing.T) {
+		c, _ := NewCompact(107892, 30000, 1001)
+		b := c.AppendFormat([]byte("TC="))
+		assert.Equal(t, "TC=01:00:00:00", string(b))
+	})
+	t.Run("no allocation", func(t *testing.T) {
+		c, _ := NewCompact(107892, 30000, 1001)
+		buf := make([]byte, 0, 16)
+		allocs := testing.AllocsPerRun(100, func() {
+			c2, _ := c.AddFrames(1)
+			c3, _ := c2.Sub(c)
+			_, _ = c3.SubFrames(10)
+			buf = c2.AppendFormat(buf[:0])
+			_ = c2.Frames()
+		})
+		assert.Equal(t, 0.0, allocs)
+	})
+	t.Run("zero value", func(t *testing.T) {
+		var c Compact
+		_, err := c.AddFrames(1)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+		_, err = c.SubFrames(0)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+		_, err = c.Add(Compact{})
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+		assert.Nil(t, c.Timecode())
+		assert.Equal(t, FrameRate{}, c.FrameRate())
+		assert.Equal(t, time.Duration(0), c.Duration())
+		assert.Equal(t, "<nil>", c.String())
+
+		c2, _ := NewCompact(1, 25, 1)
+		_, err = c2.Add(c)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+	})
+	t.Run("custom frame rate", func(t *testing.T) {
+		c, err := NewCompact(5760, 96, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, FrameRate{Numerator: 96, Denominator: 1}, c.FrameRate())
+		assert.Equal(t, "00:01:00:00", c.String())
+	})
+	t.Run("error/too many frames", func(t *testing.T) {
+		_, err := NewCompact(2589408, 30000, 1001)
+		assert.Equal(t, ErrTooManyFrames, err)
+
+		c, _ := NewCompact(2589407, 30000, 1001)
+		_, err = c.AddFrames(1)
+		assert.Equal(t, ErrTooManyFrames, err)
+		_, err = c.AddFrames(1<<64 - 1)
+		assert.Equal(t, ErrTooManyFrames, err)
+
+		tc, _ := NewTimecode(2589408, 30000, 1001, func(p *TimecodeOptionParam) {
+			p.Extended = true
+		})
+		_, err = tc.Compact()
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+	t.Run("error/underflow", func(t *testing.T) {
+		c, _ := NewCompact(1, 25, 1)
+		_, err := c.SubFrames(2)
+		assert.Equal(t, ErrUnderflowFrames, err)
+
+		tc, _ := NewTimecode(0, 25, 1, func(p *TimecodeOptionParam) {
+			p.Signed = true
+		})
+		tc, _ = tc.SubFrames(1)
+		_, err = tc.Compact()
+		assert.Equal(t, ErrUnderflowFrames, err)
+	})
+	t.Run("error/mismatch frame rate", func(t *testing.T) {
+		c1, _ := NewCompact(1, 25, 1)
+		c2, _ := NewCompact(1, 24, 1)
+		_, err := c1.Add(c2)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+		_, err = c1.Sub(c2)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+	})
+}
+
+func BenchmarkTimecodeAddFrames(b *testing.B) {
+	tc, _ := NewTimecode(107892, 30000, 1001)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = tc.AddFrames(1)
+	}
+}
+
+func BenchmarkCompactAddFrames(b *testing.B) {
+	c, _ := NewCompact(107892, 30000, 1001)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = c.AddFrames(1)
+	}
+}
+
+func BenchmarkCompactSub(b *testing.B) {
+	c1, _ := NewCompact(107892, 30000, 1001)
+	c2, _ := NewCompact(1800, 30000, 1001)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = c1.Sub(c2)
+	}
+}
+
+func BenchmarkTimecodeString(b *testing.B) {
+	tc, _ := NewTimecode(107892, 30000, 1001)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = tc.String()
+	}
+}
+
+func BenchmarkCompactAppendFormat(b *testing.B) {
+	c, _ := NewCompact(107892, 30000, 1001)
+	buf := make([]byte, 0, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = c.AppendFormat(buf[:0])
+	}
+}
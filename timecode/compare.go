@@ -0,0 +1,97 @@
+package timecode
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// Compare compares frames of Timecode and Timecode of the same frame rate.
+// It returns -1 if tc is before other, +1 if tc is after other, and 0 if they are the same.
+// Unlike Before, After and Equal, it is strict about frame rate including DF, and returns ErrMismatchFrameRate otherwise.
+func (tc *Timecode) Compare(other *Timecode) (int, error) {
+	if !tc.r.equal(other.r) {
+		return 0, ErrMismatchFrameRate
+	}
+	a, b := tc.SignedFrames(), other.SignedFrames()
+	switch {
+	case a < b:
+		return -1, nil
+	case a > b:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Before returns whether tc is before other in real time.
+// Timecodes of different frame rates are compared by their real elapsed time.
+func (tc *Timecode) Before(other *Timecode) bool {
+	return compareRealTime(tc, other) < 0
+}
+
+// After returns whether tc is after other in real time.
+// Timecodes of different frame rates are compared by their real elapsed time.
+func (tc *Timecode) After(other *Timecode) bool {
+	return compareRealTime(tc, other) > 0
+}
+
+// Equal returns whether tc and other represent the same real time.
+// Timecodes of different frame rates are compared by their real elapsed time,
+// e.g. 00:00:01:00 at 25fps equals 00:00:01:00 at 30fps. Use Compare for strict comparison of frames.
+func (tc *Timecode) Equal(other *Timecode) bool {
+	return compareRealTime(tc, other) == 0
+}
+
+// compareRealTime compares real elapsed time of a and b exactly.
+func compareRealTime(a, b *Timecode) int {
+	if a.Negative != b.Negative {
+		if a.Negative {
+			return -1
+		}
+		return 1
+	}
+
+	// a.Frames() * a.den / a.num <=> b.Frames() * b.den / b.num
+	ahi, alo := bits.Mul64(a.Frames()*uint64(a.r.denominator), uint64(b.r.numerator))
+	bhi, blo := bits.Mul64(b.Frames()*uint64(b.r.denominator), uint64(a.r.numerator))
+	c := 0
+	switch {
+	case ahi < bhi || ahi == bhi && alo < blo:
+		c = -1
+	case ahi > bhi || ahi == bhi && alo > blo:
+		c = 1
+	}
+	if a.Negative {
+		return -c
+	}
+	return c
+}
+
+// Sort sorts Timecodes in ascending order of real time.
+// The sort is stable, so equal Timecodes keep their original order.
+func Sort(tcs []*Timecode) {
+	sort.SliceStable(tcs, func(i, j int) bool {
+		return tcs[i].Before(tcs[j])
+	})
+}
+
+// Min returns the earliest Timecode in real time, or nil if tcs is empty.
+func Min(tcs ...*Timecode) *Timecode {
+	var min *Timecode
+	for _, tc := range tcs {
+		if min == nil || tc.Before(min) {
+			min = tc
+		}
+	}
+	return min
+}
+
+// Max returns the latest Timecode in real time, or nil if tcs is empty.
+func Max(tcs ...*Timecode) *Timecode {
+	var max *Timecode
+	for _, tc := range tcs {
+		if max == nil || tc.After(max) {
+			max = tc
+		}
+	}
+	return max
+}
@@ -0,0 +1,100 @@
+package timecode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	t.Run("same frame rate", func(t *testing.T) {
+		tc1, _ := NewTimecode(1799, 30000, 1001)
+		tc2, _ := NewTimecode(1800, 30000, 1001)
+
+		c, err := tc1.Compare(tc2)
+		assert.NoError(t, err)
+		assert.Equal(t, -1, c)
+		c, err = tc2.Compare(tc1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, c)
+		c, err = tc1.Compare(tc1)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, c)
+	})
+	t.Run("signed", func(t *testing.T) {
+		zero, _ := NewTimecode(0, 25, 1, func(p *TimecodeOptionParam) {
+			p.Signed = true
+		})
+		tc1, _ := zero.SubFrames(10)
+		tc2, _ := zero.SubFrames(5)
+
+		c, err := tc1.Compare(tc2)
+		assert.NoError(t, err)
+		assert.Equal(t, -1, c)
+		assert.True(t, tc1.Before(tc2))
+		assert.True(t, tc2.Before(zero))
+		assert.True(t, zero.After(tc1))
+	})
+	t.Run("error/mismatch frame rate", func(t *testing.T) {
+		tc1, _ := NewTimecode(1800, 30000, 1001)
+		tc2, _ := NewTimecode(1800, 30000, 1001, func(p *TimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		c, err := tc1.Compare(tc2)
+		assert.Equal(t, 0, c)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+	})
+	t.Run("error/different frame rate of the same real time", func(t *testing.T) {
+		tc1, _ := NewTimecode(25, 25, 1)
+		tc2, _ := NewTimecode(30, 30, 1)
+		_, err := tc1.Compare(tc2)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+		assert.True(t, tc1.Equal(tc2))
+	})
+}
+
+func TestBeforeAfterEqual(t *testing.T) {
+	t.Run("same frame rate", func(t *testing.T) {
+		tc1, _ := NewTimecode(100, 25, 1)
+		tc2, _ := NewTimecode(101, 25, 1)
+		assert.True(t, tc1.Before(tc2))
+		assert.False(t, tc2.Before(tc1))
+		assert.True(t, tc2.After(tc1))
+		assert.False(t, tc1.After(tc2))
+		assert.False(t, tc1.Equal(tc2))
+		assert.True(t, tc1.Equal(tc1))
+	})
+	t.Run("different frame rates", func(t *testing.T) {
+		tc25, _ := NewTimecode(25, 25, 1)
+		tc24, _ := NewTimecode(24, 24, 1)
+		tc23, _ := NewTimecode(24, 24000, 1001)
+		assert.True(t, tc25.Equal(tc24))
+		assert.True(t, tc24.Before(tc23)) // 1s < 1.001s
+		assert.True(t, tc23.After(tc25))
+	})
+	t.Run("DF and NDF", func(t *testing.T) {
+		df, _ := NewTimecode(1800, 30000, 1001)
+		ndf, _ := NewTimecode(1800, 30000, 1001, func(p *TimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.Equal(t, "00:01:00:02", df.String())
+		assert.Equal(t, "00:01:00:00", ndf.String())
+		assert.True(t, df.Equal(ndf))
+	})
+}
+
+func TestSortMinMax(t *testing.T) {
+	tc1, _ := ParseTimecode("01:00:00:00", 25, 1)
+	tc2, _ := ParseTimecode("00:30:00:00", 24, 1)
+	tc3, _ := ParseTimecode("00:30:00:00", 24000, 1001)
+	tc4, _ := ParseTimecode("00:00:10:00", 25, 1)
+
+	tcs := []*Timecode{tc1, tc2, tc3, tc4}
+	Sort(tcs)
+	assert.Equal(t, []*Timecode{tc4, tc2, tc3, tc1}, tcs)
+
+	assert.Equal(t, tc4, Min(tc1, tc2, tc3, tc4))
+	assert.Equal(t, tc1, Max(tc1, tc2, tc3, tc4))
+	assert.Nil(t, Min())
+	assert.Nil(t, Max())
+}
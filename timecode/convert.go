@@ -0,0 +1,102 @@
+package timecode
+
+// ConvertStrategy represents strategy of frame rate conversion.
+type ConvertStrategy int
+
+const (
+	PreserveRealTime ConvertStrategy = iota // keep real elapsed time, e.g. PAL/NTSC versioning
+	PreserveLabel                           // keep timecode label, i.e. speed change
+	PreserveFrames                          // keep number of frames, e.g. 23.976 to 24 speed-up
+)
+
+// ConvertRateOptionParam represents ConvertRate option parameter.
+type ConvertRateOptionParam struct {
+	Strategy ConvertStrategy
+	Rounding Rounding
+}
+
+// ConvertRateOption represents ConvertRate option.
+type ConvertRateOption func(*ConvertRateOptionParam)
+
+// newConvertRateOptionParam returns new ConvertRateOptionParam.
+func newConvertRateOptionParam() ConvertRateOptionParam {
+	return ConvertRateOptionParam{
+		Strategy: PreserveRealTime,
+		Rounding: RoundNearest,
+	}
+}
+
+// applyConvertRateOption applies ConvertRateOption to ConvertRateOptionParam.
+func (p *ConvertRateOptionParam) applyConvertRateOption(opts ...ConvertRateOption) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// ConvertRate returns new Timecode converted to FrameRate.
+// By default, real elapsed time is preserved and frames are rounded to the nearest.
+func (tc *Timecode) ConvertRate(fr FrameRate, opts ...ConvertRateOption) (*Timecode, error) {
+	p := newConvertRateOptionParam()
+	p.applyConvertRateOption(opts...)
+
+	r, err := fr.rate()
+	if err != nil {
+		return nil, err
+	}
+
+	conv := *tc
+	conv.r = r
+	conv.preferDF = r.dropFrames != 0
+	if r.dropFrames == 0 {
+		conv.lastSep = conv.sep
+	}
+
+	// rounding is applied to the magnitude, so floor and ceil are swapped for negative
+	rounding := p.Rounding
+	if tc.Negative {
+		switch rounding {
+		case RoundFloor:
+			rounding = RoundCeil
+		case RoundCeil:
+			rounding = RoundFloor
+		}
+	}
+
+	var frames uint64
+	switch p.Strategy {
+	case PreserveRealTime:
+		var ok bool
+		frames, ok = mulDiv(
+			tc.Frames(),
+			uint64(tc.r.denominator)*uint64(r.numerator),
+			uint64(tc.r.numerator)*uint64(r.denominator),
+			rounding,
+		)
+		if !ok {
+			return nil, ErrTooManyFrames
+		}
+	case PreserveLabel:
+		seconds := tc.HH*3600 + tc.MM*60 + tc.SS
+		fps := uint64(r.roundFPS)
+		ff, _ := mulDiv(tc.FF, fps, uint64(tc.r.roundFPS), rounding)
+		if ff >= fps {
+			seconds++
+			ff -= fps
+		}
+		conv.HH = seconds / 3600
+		conv.MM = seconds / 60 % 60
+		conv.SS = seconds % 60
+		conv.FF = ff
+		if r.isDropped(conv.HH, conv.MM, conv.SS, conv.FF) {
+			conv.FF = uint64(r.dropFrames) // skip dropped label
+		}
+		frames = conv.Frames()
+	case PreserveFrames:
+		frames = tc.Frames()
+	}
+
+	if tc.Negative {
+		return resetSigned(&conv, -int64(frames))
+	}
+	return resetSigned(&conv, int64(frames))
+}
@@ -0,0 +1,105 @@
+package timecode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertRate(t *testing.T) {
+	strategy := func(s ConvertStrategy) ConvertRateOption {
+		return func(p *ConvertRateOptionParam) {
+			p.Strategy = s
+		}
+	}
+	rounding := func(r Rounding) ConvertRateOption {
+		return func(p *ConvertRateOptionParam) {
+			p.Rounding = r
+		}
+	}
+
+	t.Run("real time/25 to 29.97DF", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00:00", 25, 1)
+		conv, err := tc.ConvertRate(Rate29_97DF)
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", conv.String())
+		assert.Equal(t, Rate29_97DF, conv.FrameRate())
+
+		tc, _ = ParseTimecode("00:00:00:01", 25, 1)
+		conv, err = tc.ConvertRate(Rate29_97DF) // 1.1988 frames
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:00:01", conv.String())
+	})
+	t.Run("real time/29.97NDF to 25", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00:00", 30000, 1001, func(p *ParseTimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		conv, err := tc.ConvertRate(Rate25)
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:03:15", conv.String()) // 3603.6s
+		assert.Equal(t, tc.Duration(), conv.Duration())
+	})
+	t.Run("real time/rounding", func(t *testing.T) {
+		tc, _ := NewTimecode(3, 25, 1) // 3.5964 frames at 29.97
+		conv, err := tc.ConvertRate(Rate29_97NDF, rounding(RoundFloor))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), conv.Frames())
+		conv, err = tc.ConvertRate(Rate29_97NDF, rounding(RoundNearest))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), conv.Frames())
+		conv, err = tc.ConvertRate(Rate29_97NDF, rounding(RoundCeil))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), conv.Frames())
+	})
+	t.Run("label/25 to 29.97NDF", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00:12", 25, 1)
+		conv, err := tc.ConvertRate(Rate29_97NDF, strategy(PreserveLabel))
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:14", conv.String())
+	})
+	t.Run("label/carry", func(t *testing.T) {
+		tc, _ := ParseTimecode("00:00:59:29", 30, 1)
+		conv, err := tc.ConvertRate(Rate25, strategy(PreserveLabel), rounding(RoundCeil))
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", conv.String())
+
+		conv, err = tc.ConvertRate(Rate25, strategy(PreserveLabel), rounding(RoundFloor))
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59:24", conv.String())
+	})
+	t.Run("label/dropped label", func(t *testing.T) {
+		tc, _ := ParseTimecode("00:01:00:00", 25, 1)
+		conv, err := tc.ConvertRate(Rate29_97DF, strategy(PreserveLabel))
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:02", conv.String())
+	})
+	t.Run("frames/23.976 to 24", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00:00", 24000, 1001)
+		conv, err := tc.ConvertRate(Rate24, strategy(PreserveFrames))
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", conv.String())
+		assert.Equal(t, tc.Frames(), conv.Frames())
+		assert.True(t, conv.Before(tc))
+	})
+	t.Run("signed", func(t *testing.T) {
+		zero, _ := NewTimecode(0, 25, 1, func(p *TimecodeOptionParam) {
+			p.Signed = true
+		})
+		tc, _ := zero.SubFrames(3)
+		conv, err := tc.ConvertRate(Rate29_97NDF, rounding(RoundFloor))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-4), conv.SignedFrames())
+	})
+	t.Run("error/too many frames", func(t *testing.T) {
+		tc, _ := ParseTimecode("23:59:59:24", 25, 1)
+		conv, err := tc.ConvertRate(Rate24, strategy(PreserveFrames))
+		assert.Nil(t, conv)
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+	t.Run("error/unsupported", func(t *testing.T) {
+		tc, _ := ParseTimecode("00:00:00:00", 25, 1)
+		conv, err := tc.ConvertRate(FrameRate{Numerator: 25, Denominator: 1, DropFrame: true})
+		assert.Nil(t, conv)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+	})
+}
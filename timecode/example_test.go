@@ -2,6 +2,7 @@ package timecode_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/abema/go-timecode/timecode"
 )
@@ -92,3 +93,93 @@ func ExampleTimecode_String() {
 	fmt.Println(tc.String())
 	// Output: 00:01:00:04
 }
+
+func ExampleParseFrameRate() {
+	fr, err := timecode.ParseFrameRate("30000/1001")
+	if err != nil {
+		panic(1)
+	}
+	fmt.Println(fr)
+	// Output: 29.97DF
+}
+
+func ExampleNewTimecodeWithRate() {
+	tc, err := timecode.NewTimecodeWithRate(1800, timecode.Rate29_97NDF)
+	if err != nil {
+		panic(1)
+	}
+	fmt.Println(tc)
+	// Output: 00:01:00:00
+}
+
+func ExampleFromDuration() {
+	tc, err := timecode.FromDuration(time.Hour, 30000, 1001, func(p *timecode.TimecodeOptionParam) {
+		p.Rounding = timecode.RoundNearest
+	})
+	if err != nil {
+		panic(1)
+	}
+	fmt.Println(tc)
+	// Output: 01:00:00:00
+}
+
+func ExampleTimecode_SubWrap() {
+	start, err := timecode.ParseTimecode("23:30:00:00", 25, 1)
+	if err != nil {
+		panic(1)
+	}
+	end, err := timecode.ParseTimecode("00:15:00:00", 25, 1)
+	if err != nil {
+		panic(1)
+	}
+	d, wrapped, _ := end.SubWrap(start)
+	fmt.Println(d, wrapped)
+	// Output: 00:45:00:00 true
+}
+
+func ExampleTimecode_ConvertRate() {
+	tc, err := timecode.ParseTimecode("10:00:00:00", 25, 1)
+	if err != nil {
+		panic(1)
+	}
+	conv, _ := tc.ConvertRate(timecode.Rate29_97DF)
+	fmt.Println(conv)
+	// Output: 10:00:00:01
+}
+
+func ExampleTimecode_FormatLayout() {
+	tc, err := timecode.ParseTimecode("01:23:45:12", 25, 1)
+	if err != nil {
+		panic(1)
+	}
+	fmt.Println(tc.FormatLayout(timecode.LayoutCompact))
+	fmt.Println(tc.FormatLayout(timecode.LayoutSubtitle))
+	// Output:
+	// 01234512
+	// 01:23:45.480
+}
+
+func ExampleTimecode_Format() {
+	tc, err := timecode.ParseTimecode("01:00:00;00", 30000, 1001)
+	if err != nil {
+		panic(1)
+	}
+	fmt.Printf("%v %+v %d\n", tc, tc, tc)
+	// Output: 01:00:00;00 01:00:00;00@29.97DF 107892
+}
+
+func ExampleParseTimecodeFlexible() {
+	for _, s := range []string{"1:00:00:00", "10:00", "1000000", "+120", "3600s"} {
+		tc, err := timecode.ParseTimecodeFlexible(s, 24, 1)
+		if err != nil {
+			panic(1)
+		}
+		fmt.Println(tc)
+	}
+	// Output:
+	// 01:00:00:00
+	// 00:00:10:00
+	// 01:00:00:00
+	// 00:00:05:00
+	// 01:00:00:00
+}
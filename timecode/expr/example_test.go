@@ -0,0 +1,16 @@
+package expr_test
+
+import (
+	"fmt"
+
+	"github.com/abema/go-timecode/timecode/expr"
+)
+
+func ExampleEval() {
+	tc, err := expr.Eval("01:00:00:00 + 00:00:10:12 - 48f", 24, 1)
+	if err != nil {
+		panic(1)
+	}
+	fmt.Println(tc)
+	// Output: 01:00:08:12
+}
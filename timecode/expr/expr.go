@@ -0,0 +1,377 @@
+// Package expr evaluates arithmetic expressions over timecodes, e.g. "01:00:00:00 + 00:00:10:12 - 48f".
+//
+// Operands are
+//
+//	01:00:00:00, 10:00  timecode, partial forms are accepted as timecode.ParseTimecodeFlexible
+//	48f                 number of frames
+//	1.5s, 500ms         real time, rounded to the nearest frame
+//	2                   scalar
+//
+// Operators are binary + - * /, unary - and parentheses.
+// Timecode + Timecode and Timecode - Timecode are evaluated by Add and Sub,
+// and Timecode + frames and Timecode - frames by AddFrames and SubFrames.
+// Timecode and frames can be multiplied and divided by scalar.
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abema/go-timecode/timecode"
+)
+
+var (
+	ErrDivisionByZero = fmt.Errorf("division by zero: %w", timecode.ErrInvalidTimecode) // error for division by zero, wrapping timecode.ErrInvalidTimecode
+)
+
+// Error represents error of evaluating expression.
+type Error struct {
+	Input  string // expression being evaluated
+	Offset int    // byte offset of the offending token in Input
+	Reason string // description of the failure
+	Err    error  // underlying error, e.g. timecode.ErrUnderflowFrames
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return "eval " + strconv.Quote(e.Input) + ": " + e.Reason + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// Unwrap returns underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Eval evaluates expression at frame rate num/den and returns Timecode.
+// opts are used to parse timecode literals, e.g. Signed to allow negative result.
+func Eval(s string, num, den int32, opts ...timecode.ParseTimecodeOption) (*timecode.Timecode, error) {
+	return eval(s, func(lit string) (*timecode.Timecode, error) {
+		return timecode.ParseTimecodeFlexible(lit, num, den, opts...)
+	})
+}
+
+// EvalWithRate evaluates expression at FrameRate and returns Timecode.
+func EvalWithRate(s string, fr timecode.FrameRate, opts ...timecode.ParseTimecodeOption) (*timecode.Timecode, error) {
+	return eval(s, func(lit string) (*timecode.Timecode, error) {
+		return timecode.ParseTimecodeFlexibleWithRate(lit, fr, opts...)
+	})
+}
+
+// eval evaluates expression with parse function of timecode literals.
+func eval(s string, parse func(string) (*timecode.Timecode, error)) (*timecode.Timecode, error) {
+	zero, err := parse("+0")
+	if err != nil {
+		return nil, err
+	}
+
+	e := &evaluator{input: s, parse: parse, zero: zero}
+	v, err := e.expr()
+	if err != nil {
+		return nil, err
+	}
+	e.skipSpaces()
+	if e.pos < len(s) {
+		return nil, e.errorf(e.pos, "unexpected "+strconv.QuoteRune(rune(s[e.pos])), timecode.ErrInvalidTimecode)
+	}
+	return e.toTimecode(v, 0)
+}
+
+// kind represents kind of value.
+type kind int
+
+const (
+	kindScalar kind = iota
+	kindFrames
+	kindTimecode
+)
+
+// value represents evaluated value.
+type value struct {
+	kind   kind
+	n      int64 // scalar or number of frames
+	tc     *timecode.Timecode
+	offset int
+}
+
+// evaluator represents recursive descent evaluator.
+type evaluator struct {
+	input string
+	pos   int
+	parse func(string) (*timecode.Timecode, error)
+	zero  *timecode.Timecode
+}
+
+// errorf returns Error at offset.
+func (e *evaluator) errorf(offset int, reason string, err error) *Error {
+	return &Error{
+		Input:  e.input,
+		Offset: offset,
+		Reason: reason,
+		Err:    err,
+	}
+}
+
+// skipSpaces skips white spaces.
+func (e *evaluator) skipSpaces() {
+	for e.pos < len(e.input) && (e.input[e.pos] == ' ' || e.input[e.pos] == '\t') {
+		e.pos++
+	}
+}
+
+// peek returns the next non-space character, or 0 at the end.
+func (e *evaluator) peek() byte {
+	e.skipSpaces()
+	if e.pos < len(e.input) {
+		return e.input[e.pos]
+	}
+	return 0
+}
+
+// expr evaluates term (('+' | '-') term)*.
+func (e *evaluator) expr() (value, error) {
+	lhs, err := e.term()
+	if err != nil {
+		return value{}, err
+	}
+	for {
+		op := e.peek()
+		if op != '+' && op != '-' {
+			return lhs, nil
+		}
+		offset := e.pos
+		e.pos++
+		rhs, err := e.term()
+		if err != nil {
+			return value{}, err
+		}
+		if lhs, err = e.addSub(op, lhs, rhs, offset); err != nil {
+			return value{}, err
+		}
+	}
+}
+
+// term evaluates unary (('*' | '/') unary)*.
+func (e *evaluator) term() (value, error) {
+	lhs, err := e.unary()
+	if err != nil {
+		return value{}, err
+	}
+	for {
+		op := e.peek()
+		if op != '*' && op != '/' {
+			return lhs, nil
+		}
+		offset := e.pos
+		e.pos++
+		rhs, err := e.unary()
+		if err != nil {
+			return value{}, err
+		}
+		if lhs, err = e.mulDiv(op, lhs, rhs, offset); err != nil {
+			return value{}, err
+		}
+	}
+}
+
+// unary evaluates '-' unary | primary.
+func (e *evaluator) unary() (value, error) {
+	if e.peek() != '-' {
+		return e.primary()
+	}
+	offset := e.pos
+	e.pos++
+	v, err := e.unary()
+	if err != nil {
+		return value{}, err
+	}
+	zero := value{kind: v.kind, tc: e.zero, offset: offset}
+	if v.kind != kindTimecode {
+		zero.tc = nil
+	}
+	return e.addSub('-', zero, v, offset)
+}
+
+// primary evaluates '(' expr ')' | literal.
+func (e *evaluator) primary() (value, error) {
+	switch c := e.peek(); {
+	case c == '(':
+		e.pos++
+		v, err := e.expr()
+		if err != nil {
+			return value{}, err
+		}
+		if e.peek() != ')' {
+			return value{}, e.errorf(e.pos, "missing ')'", timecode.ErrInvalidTimecode)
+		}
+		e.pos++
+		return v, nil
+	case c == 0:
+		return value{}, e.errorf(e.pos, "missing operand", timecode.ErrInvalidTimecode)
+	case !isLiteral(c):
+		return value{}, e.errorf(e.pos, "unexpected "+strconv.QuoteRune(rune(c)), timecode.ErrInvalidTimecode)
+	}
+	return e.literal()
+}
+
+// isLiteral reports whether c is a character of number or timecode.
+func isLiteral(c byte) bool {
+	return '0' <= c && c <= '9' || strings.IndexByte(":;.,", c) >= 0
+}
+
+// literal evaluates timecode, frames, real time or scalar literal.
+func (e *evaluator) literal() (value, error) {
+	offset := e.pos
+	for e.pos < len(e.input) && isLiteral(e.input[e.pos]) {
+		e.pos++
+	}
+	number := e.input[offset:e.pos]
+	for e.pos < len(e.input) && 'a' <= e.input[e.pos] && e.input[e.pos] <= 'z' {
+		e.pos++
+	}
+	unit := e.input[offset+len(number) : e.pos]
+	lit := e.input[offset:e.pos]
+
+	switch unit {
+	case "":
+		if strings.IndexAny(number, ":;.,") < 0 {
+			n, err := strconv.ParseInt(number, 10, 64)
+			if err != nil {
+				return value{}, e.errorf(offset, "scalar "+strconv.Quote(lit)+" is out of range", timecode.ErrTooManyFrames)
+			}
+			return value{kind: kindScalar, n: n, offset: offset}, nil
+		}
+		tc, err := e.parse(number)
+		if err != nil {
+			return value{}, e.errorf(offset, "invalid timecode "+strconv.Quote(lit), err)
+		}
+		return value{kind: kindTimecode, tc: tc, offset: offset}, nil
+	case "f":
+		n, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return value{}, e.errorf(offset, "invalid frames "+strconv.Quote(lit), timecode.ErrInvalidTimecode)
+		}
+		return value{kind: kindFrames, n: n, offset: offset}, nil
+	case "s", "ms":
+		d, err := time.ParseDuration(lit)
+		if err != nil {
+			return value{}, e.errorf(offset, "invalid duration "+strconv.Quote(lit), timecode.ErrInvalidTimecode)
+		}
+		tc, err := timecode.FromDurationWithRate(d, e.zero.FrameRate(), func(p *timecode.TimecodeOptionParam) {
+			p.Rounding = timecode.RoundNearest
+			p.Extended = true
+		})
+		if err != nil {
+			return value{}, e.errorf(offset, "duration "+strconv.Quote(lit)+" is out of range", err)
+		}
+		return value{kind: kindFrames, n: tc.SignedFrames(), offset: offset}, nil
+	default:
+		return value{}, e.errorf(offset+len(number), "unknown unit "+strconv.Quote(unit), timecode.ErrInvalidTimecode)
+	}
+}
+
+// addSub evaluates lhs + rhs or lhs - rhs.
+func (e *evaluator) addSub(op byte, lhs, rhs value, offset int) (value, error) {
+	if lhs.kind == kindTimecode || rhs.kind == kindTimecode {
+		a, err := e.toTimecode(lhs, offset)
+		if err != nil {
+			return value{}, err
+		}
+		var tc *timecode.Timecode
+		switch {
+		case rhs.kind != kindTimecode && op == '+':
+			tc, err = addFrames(a, rhs.n)
+		case rhs.kind != kindTimecode:
+			tc, err = addFrames(a, -rhs.n)
+		case op == '+':
+			tc, err = a.Add(rhs.tc)
+		default:
+			tc, err = a.Sub(rhs.tc)
+		}
+		if err != nil {
+			return value{}, e.errorf(offset, "cannot evaluate "+strconv.QuoteRune(rune(op)), err)
+		}
+		return value{kind: kindTimecode, tc: tc, offset: lhs.offset}, nil
+	}
+
+	if rhs.kind != lhs.kind {
+		return value{}, e.errorf(offset, "cannot add or subtract scalar and frames", timecode.ErrInvalidTimecode)
+	}
+	n := rhs.n
+	if op == '-' {
+		if n == math.MinInt64 {
+			return value{}, e.errorf(offset, "frames overflow", timecode.ErrTooManyFrames)
+		}
+		n = -n
+	}
+	sum := lhs.n + n
+	if (n > 0 && sum < lhs.n) || (n < 0 && sum > lhs.n) {
+		return value{}, e.errorf(offset, "frames overflow", timecode.ErrTooManyFrames)
+	}
+	return value{kind: lhs.kind, n: sum, offset: lhs.offset}, nil
+}
+
+// mulDiv evaluates lhs * rhs or lhs / rhs.
+func (e *evaluator) mulDiv(op byte, lhs, rhs value, offset int) (value, error) {
+	if op == '*' && lhs.kind == kindScalar {
+		lhs, rhs = rhs, lhs
+	}
+	if rhs.kind != kindScalar {
+		return value{}, e.errorf(offset, "cannot multiply or divide by non-scalar", timecode.ErrInvalidTimecode)
+	}
+
+	n := lhs.n
+	if lhs.kind == kindTimecode {
+		n = lhs.tc.SignedFrames()
+	}
+	var result int64
+	if op == '*' {
+		result = n * rhs.n
+		if n != 0 && (result/n != rhs.n || (n == -1 && rhs.n == math.MinInt64)) {
+			return value{}, e.errorf(offset, "frames overflow", timecode.ErrTooManyFrames)
+		}
+	} else {
+		if rhs.n == 0 {
+			return value{}, e.errorf(offset, "cannot divide by zero", ErrDivisionByZero)
+		}
+		if n == math.MinInt64 && rhs.n == -1 {
+			return value{}, e.errorf(offset, "frames overflow", timecode.ErrTooManyFrames)
+		}
+		result = n / rhs.n
+	}
+
+	if lhs.kind != kindTimecode {
+		return value{kind: lhs.kind, n: result, offset: lhs.offset}, nil
+	}
+	zero, err := timecode.Reset(lhs.tc, 0)
+	if err != nil {
+		return value{}, e.errorf(offset, "cannot evaluate "+strconv.QuoteRune(rune(op)), err)
+	}
+	tc, err := addFrames(zero, result)
+	if err != nil {
+		return value{}, e.errorf(offset, "cannot evaluate "+strconv.QuoteRune(rune(op)), err)
+	}
+	return value{kind: kindTimecode, tc: tc, offset: lhs.offset}, nil
+}
+
+// toTimecode returns Timecode of value, frames and scalar are counted from zero.
+func (e *evaluator) toTimecode(v value, offset int) (*timecode.Timecode, error) {
+	if v.kind == kindTimecode {
+		return v.tc, nil
+	}
+	tc, err := addFrames(e.zero, v.n)
+	if err != nil {
+		return nil, e.errorf(offset, "cannot convert frames to timecode", err)
+	}
+	return tc, nil
+}
+
+// addFrames returns tc + frames by AddFrames or SubFrames.
+func addFrames(tc *timecode.Timecode, frames int64) (*timecode.Timecode, error) {
+	if frames < 0 {
+		return tc.SubFrames(uint64(-frames))
+	}
+	return tc.AddFrames(uint64(frames))
+}
@@ -0,0 +1,105 @@
+package expr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/abema/go-timecode/timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEval(t *testing.T) {
+	t.Run("expressions", func(t *testing.T) {
+		for _, tt := range []struct {
+			s    string
+			want string
+		}{
+			{"01:00:00:00", "01:00:00:00"},
+			{"01:00:00:00 + 00:00:10:12 - 48f", "01:00:08:12"},
+			{"01:00:00:00+00:00:10:12-48f", "01:00:08:12"},
+			{"10:00:00:00 * 2", "20:00:00:00"},
+			{"2 * 10:00:00:00", "20:00:00:00"},
+			{"10:00:00:00 / 4", "02:30:00:00"},
+			{"01:00:00:00 - (00:10:00:00 + 00:05:00:00)", "00:45:00:00"},
+			{"01:00:00:00 - 00:10:00:00 + 00:05:00:00", "00:55:00:00"},
+			{"10:00 + 1.5s", "00:00:11:12"},
+			{"00:00:01:00 + 500ms", "00:00:01:12"},
+			{"48f", "00:00:02:00"},
+			{"24f * 3 + 1f", "00:00:03:01"},
+			{"(1 + 2) * 24f", "00:00:03:00"},
+			{"01:00:00:00 - -24f", "01:00:01:00"},
+			{"100", "00:00:04:04"},
+		} {
+			tc, err := Eval(tt.s, 24, 1)
+			if assert.NoError(t, err, tt.s) {
+				assert.Equal(t, tt.want, tc.String(), tt.s)
+			}
+		}
+	})
+	t.Run("DF", func(t *testing.T) {
+		tc, err := EvalWithRate("00:00:59;29 + 1f", timecode.Rate29_97DF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00;02", tc.String())
+
+		tc, err = EvalWithRate("10:00:00;00 * 2", timecode.Rate29_97DF)
+		assert.NoError(t, err)
+		assert.Equal(t, "20:00:00;00", tc.String())
+	})
+	t.Run("signed", func(t *testing.T) {
+		tc, err := Eval("00:00:01:00 - 00:00:02:00", 25, 1, func(p *timecode.ParseTimecodeOptionParam) {
+			p.Signed = true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "-00:00:01:00", tc.String())
+
+		tc, err = Eval("-00:00:01:00 * 2", 25, 1, func(p *timecode.ParseTimecodeOptionParam) {
+			p.Signed = true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "-00:00:02:00", tc.String())
+	})
+	t.Run("error", func(t *testing.T) {
+		for _, tt := range []struct {
+			s      string
+			err    error
+			offset int
+		}{
+			{"", timecode.ErrInvalidTimecode, 0},
+			{"01:00:00:00 +", timecode.ErrInvalidTimecode, 13},
+			{"01:00:00:00 00:00:00:01", timecode.ErrInvalidTimecode, 12},
+			{"(01:00:00:00", timecode.ErrInvalidTimecode, 12},
+			{"01:00:00:00 + x", timecode.ErrInvalidTimecode, 14},
+			{"01:60:00:00", timecode.ErrInvalidTimecode, 0},
+			{"1h", timecode.ErrInvalidTimecode, 1},
+			{"00:00:01:00 - 00:00:02:00", timecode.ErrUnderflowFrames, 12},
+			{"00:00:01:00 - 26f", timecode.ErrUnderflowFrames, 12},
+			{"-00:00:01:00", timecode.ErrUnderflowFrames, 0},
+			{"20:00:00:00 * 2", timecode.ErrTooManyFrames, 12},
+			{"23:59:59:24 + 1f", timecode.ErrTooManyFrames, 12},
+			{"01:00:00:00 / 0", ErrDivisionByZero, 12},
+			{"01:00:00:00 / 0", timecode.ErrInvalidTimecode, 12},
+			{"01:00:00:00 * 00:00:00:01", timecode.ErrInvalidTimecode, 12},
+			{"24f + 1", timecode.ErrInvalidTimecode, 4},
+			{"9223372036854775807f * 2", timecode.ErrTooManyFrames, 21},
+			{"99999999999999999999", timecode.ErrTooManyFrames, 0},
+		} {
+			tc, err := Eval(tt.s, 25, 1)
+			assert.Nil(t, tc, tt.s)
+			assert.ErrorIs(t, err, tt.err, tt.s)
+
+			var eerr *Error
+			if assert.True(t, errors.As(err, &eerr), tt.s) {
+				assert.Equal(t, tt.s, eerr.Input, tt.s)
+				assert.Equal(t, tt.offset, eerr.Offset, tt.s)
+			}
+		}
+	})
+	t.Run("error/unsupported frame rate", func(t *testing.T) {
+		_, err := Eval("01:00:00:00", 1, 1)
+		assert.Equal(t, timecode.ErrUnsupportedFrameRate, err)
+	})
+	t.Run("Error", func(t *testing.T) {
+		_, err := Eval("00:00:01:00 - 26f", 25, 1)
+		assert.EqualError(t, err, `eval "00:00:01:00 - 26f": cannot evaluate '-' at offset 12`)
+	})
+}
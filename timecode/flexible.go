@@ -0,0 +1,196 @@
+package timecode
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseTimecodeFlexible returns new Timecode from string typed in the way of timecode entry fields of NLEs.
+// Accepted forms are
+//
+//	1:00:00:00, 10:00, 5  partial timecode right-aligned to frames, e.g. 10:00 is 00:00:10:00
+//	1000000               digits only, right-aligned pairs, e.g. 1000000 is 01:00:00:00
+//	+120, 120f            number of frames
+//	3600s, 1.5s           decimal seconds of real time, rounded to the nearest frame
+//
+// Layout option is ignored.
+func ParseTimecodeFlexible(s string, num, den int32, opts ...ParseTimecodeOption) (*Timecode, error) {
+	p := newParseTimecodeOptionParam()
+	p.applyParseTimecodeOption(opts...)
+
+	r, err := newApproxRate(num, den, p.PreferDF, p.Tolerance)
+	if err != nil {
+		return nil, err
+	}
+	return parseFlexible(s, r, false, p)
+}
+
+// ParseTimecodeFlexibleWithRate returns new Timecode from string typed in the way of timecode entry fields of NLEs and FrameRate.
+// PreferDF option is ignored, DropFrame of FrameRate is used instead.
+func ParseTimecodeFlexibleWithRate(s string, fr FrameRate, opts ...ParseTimecodeOption) (*Timecode, error) {
+	p := newParseTimecodeOptionParam()
+	p.applyParseTimecodeOption(opts...)
+	p.PreferDF = fr.DropFrame
+
+	r, err := fr.rate()
+	if err != nil {
+		return nil, err
+	}
+	return parseFlexible(s, r, true, p)
+}
+
+// parseFlexible returns new Timecode from string typed in the way of timecode entry fields.
+func parseFlexible(s string, r *rate, fixedRate bool, p ParseTimecodeOptionParam) (*Timecode, error) {
+	sc := &scanner{input: s}
+	negative := p.Signed && sc.sign()
+
+	switch {
+	case !negative && strings.HasPrefix(s, "+"):
+		sc.pos++
+		return parseFrameCount(sc, negative, r, p)
+	case strings.HasSuffix(s, "f"):
+		return parseFrameCount(sc, negative, r, p)
+	case strings.HasSuffix(s, "s"):
+		return parseSeconds(sc, negative, r, p)
+	}
+
+	// groups of digits and separators between them
+	var values []uint64
+	var offsets, widths []int
+	var seps []string
+	var sepOffsets []int
+	for {
+		offset := sc.pos
+		v, err := sc.digits("timecode", 1, 20)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		offsets = append(offsets, offset)
+		widths = append(widths, sc.pos-offset)
+		if sc.pos == len(s) {
+			break
+		}
+		if len(values) == 4 {
+			return nil, sc.end("frames")
+		}
+		sepOffsets = append(sepOffsets, sc.pos)
+		sep, err := sc.separator("separator", "p:;.,")
+		if err != nil {
+			return nil, err
+		}
+		seps = append(seps, sep)
+	}
+
+	ffWidth := maxFramesWidth(r)
+	if len(values) == 1 && widths[0] > ffWidth {
+		// digits only, split into right-aligned pairs
+		base := offsets[0]
+		values, offsets, widths = nil, nil, nil
+		end := len(s)
+		for _, width := range []int{ffWidth, 2, 2, len(s)} {
+			if end == base {
+				break
+			}
+			start := end - width
+			if start < base {
+				start = base
+			}
+			v, _ := strconv.ParseUint(s[start:end], 10, 64)
+			values = append([]uint64{v}, values...)
+			offsets = append([]int{start}, offsets...)
+			widths = append([]int{end - start}, widths...)
+			end = start
+		}
+	}
+
+	// right-align fields to frames
+	f := fields{negative: negative}
+	n := len(values)
+	names := []string{"hours", "minutes", "seconds", "frames"}
+	maxWidths := []int{2, 2, 2, ffWidth}
+	if p.Extended {
+		maxWidths[0] = 4
+	}
+	dst := []*uint64{&f.hh, &f.mm, &f.ss, &f.ff}
+	dstOffsets := []*int{&f.hhOffset, &f.mmOffset, &f.ssOffset, &f.ffOffset}
+	for i := range values {
+		j := 4 - n + i
+		if widths[i] > maxWidths[j] {
+			return nil, sc.errorf(names[j], offsets[i]+maxWidths[j], "has too many digits")
+		}
+		*dst[j] = values[i]
+		*dstOffsets[j] = offsets[i]
+	}
+	for j := 0; j < 4-n; j++ {
+		*dstOffsets[j] = offsets[0]
+	}
+
+	if len(seps) != 0 {
+		f.lastSep = seps[len(seps)-1]
+		f.lastSepOffset = sepOffsets[len(seps)-1]
+		if f.lastSep == "p" {
+			return nil, sc.errorf("separator", f.lastSepOffset, "has invalid character 'p'")
+		}
+		for i := 1; i < len(seps)-1; i++ {
+			if seps[i] != seps[0] {
+				return nil, sc.errorf("separator", sepOffsets[i], "is inconsistent with "+strconv.Quote(seps[0]))
+			}
+		}
+		if len(seps) > 1 {
+			f.sep = seps[0]
+		}
+	}
+	return sc.newTimecode(f, r, fixedRate, p)
+}
+
+// parseFrameCount returns new Timecode from number of frames.
+func parseFrameCount(sc *scanner, negative bool, r *rate, p ParseTimecodeOptionParam) (*Timecode, error) {
+	frames, err := sc.digits("frame count", 1, 20)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(sc.input, "f") {
+		if err := sc.suffix("frame count", 'f'); err != nil {
+			return nil, err
+		}
+	} else if err := sc.end("frame count"); err != nil {
+		return nil, err
+	}
+	return newFramesTimecode(negative, frames, r, p)
+}
+
+// parseSeconds returns new Timecode from decimal seconds, rounded to the nearest frame.
+func parseSeconds(sc *scanner, negative bool, r *rate, p ParseTimecodeOptionParam) (*Timecode, error) {
+	offset := sc.pos
+	sec, err := sc.digits("seconds", 1, 20)
+	if err != nil {
+		return nil, err
+	}
+	scale := uint64(1)
+	if sc.pos < len(sc.input) && sc.input[sc.pos] == '.' {
+		sc.pos++
+		start := sc.pos
+		frac, err := sc.digits("seconds", 1, 9)
+		if err != nil {
+			return nil, err
+		}
+		for i := start; i < sc.pos; i++ {
+			scale *= 10
+		}
+		if sec > (math.MaxUint64-frac)/scale {
+			return nil, sc.errorf("seconds", offset, "is out of range")
+		}
+		sec = sec*scale + frac
+	}
+	if err := sc.suffix("seconds", 's'); err != nil {
+		return nil, err
+	}
+
+	frames, ok := mulDiv(sec, uint64(r.numerator), scale*uint64(r.denominator), RoundNearest)
+	if !ok {
+		return nil, ErrTooManyFrames
+	}
+	return newFramesTimecode(negative, frames, r, p)
+}
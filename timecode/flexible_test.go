@@ -0,0 +1,131 @@
+package timecode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimecodeFlexible(t *testing.T) {
+	t.Run("forms", func(t *testing.T) {
+		for _, tt := range []struct {
+			s    string
+			want string
+		}{
+			{"01:00:00:00", "01:00:00:00"},
+			{"1:00:00:00", "01:00:00:00"},
+			{"1:2:3:4", "01:02:03:04"},
+			{"10:00", "00:00:10:00"},
+			{"1:10:00", "00:01:10:00"},
+			{"5", "00:00:00:05"},
+			{"12", "00:00:00:12"},
+			{"100", "00:00:01:00"},
+			{"1000000", "01:00:00:00"},
+			{"10000000", "10:00:00:00"},
+			{"100000", "00:10:00:00"},
+			{"+120", "00:00:05:00"},
+			{"120f", "00:00:05:00"},
+			{"3600s", "01:00:00:00"},
+			{"1.5s", "00:00:01:12"},
+			{"0.02s", "00:00:00:00"},
+			{"0.021s", "00:00:00:01"},
+		} {
+			tc, err := ParseTimecodeFlexible(tt.s, 24, 1)
+			if assert.NoError(t, err, tt.s) {
+				assert.Equal(t, tt.want, tc.String(), tt.s)
+			}
+		}
+	})
+	t.Run("DF", func(t *testing.T) {
+		tc, err := ParseTimecodeFlexible("1:00;00", 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00;02", tc.String())
+		assert.Equal(t, uint64(1800), tc.Frames())
+
+		tc, err = ParseTimecodeFlexible("3600s", 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", tc.String())
+		assert.Equal(t, uint64(107892), tc.Frames())
+
+		tc, err = ParseTimecodeFlexible("1:00:00", 30000, 1001, func(p *ParseTimecodeOptionParam) {
+			p.DetectDF = true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, Rate29_97NDF, tc.FrameRate())
+
+		_, err = ParseTimecodeFlexibleWithRate("1:00:00", Rate29_97DF, func(p *ParseTimecodeOptionParam) {
+			p.DetectDF = true
+		})
+		assert.ErrorIs(t, err, ErrMismatchFrameRate)
+	})
+	t.Run("120fps", func(t *testing.T) {
+		tc, err := ParseTimecodeFlexibleWithRate("1119", Rate120)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:01:119", tc.String())
+	})
+	t.Run("signed", func(t *testing.T) {
+		signed := func(p *ParseTimecodeOptionParam) {
+			p.Signed = true
+		}
+		for _, s := range []string{"-1:00", "-100", "-24f", "-1s"} {
+			tc, err := ParseTimecodeFlexible(s, 24, 1, signed)
+			assert.NoError(t, err, s)
+			assert.Equal(t, int64(-24), tc.SignedFrames(), s)
+		}
+	})
+	t.Run("extended", func(t *testing.T) {
+		tc, err := ParseTimecodeFlexible("100:00:00:00", 24, 1, func(p *ParseTimecodeOptionParam) {
+			p.Extended = true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), tc.HH)
+
+		tc, err = ParseTimecodeFlexible("1000000000", 24, 1, func(p *ParseTimecodeOptionParam) {
+			p.Extended = true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "1000:00:00:00", tc.String())
+	})
+	t.Run("error", func(t *testing.T) {
+		for _, tt := range []struct {
+			s      string
+			field  string
+			offset int
+		}{
+			{"", "timecode", 0},
+			{"1:2:3:4:5", "frames", 7},
+			{"1:60:00", "seconds", 2},
+			{"1:60:00:00", "minutes", 2},
+			{"1:00:00:60", "frames", 8},
+			{"24:00:00:00", "hours", 0},
+			{"100:00:00:00", "hours", 2},
+			{"1;00:00:00", "separator", 4},
+			{"1:00p00", "separator", 4},
+			{"1x", "separator", 1},
+			{"+", "frame count", 1},
+			{"+12x", "frame count", 3},
+			{"12xf", "frame count", 2},
+			{"1.s", "seconds", 2},
+			{"1.5xs", "seconds", 3},
+			{"-1:00", "timecode", 0},
+			{"1000000000", "hours", 2},
+		} {
+			tc, err := ParseTimecodeFlexible(tt.s, 24, 1)
+			assert.Nil(t, tc, tt.s)
+			assert.ErrorIs(t, err, ErrInvalidTimecode, tt.s)
+
+			var perr *ParseError
+			if assert.True(t, errors.As(err, &perr), tt.s) {
+				assert.Equal(t, tt.s, perr.Input, tt.s)
+				assert.Equal(t, tt.field, perr.Field, tt.s)
+				assert.Equal(t, tt.offset, perr.Offset, tt.s)
+			}
+		}
+
+		_, err := ParseTimecodeFlexible("2073600f", 24, 1)
+		assert.Equal(t, ErrTooManyFrames, err)
+		_, err = ParseTimecodeFlexible("86400s", 24, 1)
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+}
@@ -0,0 +1,351 @@
+package timecode
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layout tokens of FormatLayout and Layout option of ParseTimecode.
+// Any other character in a layout is copied literally.
+//
+//	HH  hours, 2 digits (up to 4 digits when extended)
+//	MM  minutes, 2 digits
+//	SS  seconds, 2 digits
+//	FF  frames, 2 digits (3 digits for frame rates over 100 when parsing)
+//	mmm milliseconds of real time within the second, 3 digits
+//	ff  frame pair of field based FF (FF/2), 2 digits
+//	P   field parity of field based FF, 1 for the first field and 2 for the second
+//	#   number of frames
+//
+// If a layout contains mmm, HH, MM and SS are also of real time, i.e. Duration,
+// and the layout is parsed to the nearest frame of the real time.
+// e.g. 01:00:00:00 at 29.97NDF is formatted as 01:00:03.600 by LayoutSubtitle.
+// Layout combining mmm with FF, ff or P is invalid, see ValidateLayout.
+// Negative timecode is prefixed with "-".
+const (
+	LayoutDefault  = "HH:MM:SS:FF"
+	LayoutCompact  = "HHMMSSFF"
+	LayoutSubtitle = "HH:MM:SS.mmm"
+	LayoutFrames   = "#"
+	LayoutField    = "HH:MM:SS:ff.P"
+)
+
+// layoutToken represents token of layout.
+type layoutToken int
+
+const (
+	tokenLiteral layoutToken = iota
+	tokenHours
+	tokenMinutes
+	tokenSeconds
+	tokenFrames
+	tokenMillis
+	tokenFramePair
+	tokenParity
+	tokenTotalFrames
+)
+
+// String returns field name of token.
+func (t layoutToken) String() string {
+	switch t {
+	case tokenHours:
+		return "hours"
+	case tokenMinutes:
+		return "minutes"
+	case tokenSeconds:
+		return "seconds"
+	case tokenFrames:
+		return "frames"
+	case tokenMillis:
+		return "milliseconds"
+	case tokenFramePair:
+		return "frame pair"
+	case tokenParity:
+		return "field parity"
+	case tokenTotalFrames:
+		return "frame count"
+	default:
+		return "literal"
+	}
+}
+
+// nextToken returns the next token of layout and the rest of layout.
+func nextToken(layout string) (layoutToken, string, string) {
+	for _, t := range []struct {
+		s     string
+		token layoutToken
+	}{
+		{"HH", tokenHours},
+		{"MM", tokenMinutes},
+		{"SS", tokenSeconds},
+		{"FF", tokenFrames},
+		{"mmm", tokenMillis},
+		{"ff", tokenFramePair},
+		{"P", tokenParity},
+		{"#", tokenTotalFrames},
+	} {
+		if strings.HasPrefix(layout, t.s) {
+			return t.token, t.s, layout[len(t.s):]
+		}
+	}
+	return tokenLiteral, layout[:1], layout[1:]
+}
+
+// ValidateLayout returns error wrapping ErrInvalidLayout if layout combines mmm with FF, ff or P,
+// which would mix real time with frames of timecode.
+func ValidateLayout(layout string) error {
+	var hasMillis bool
+	var frames string
+	for layout != "" {
+		var token layoutToken
+		var s string
+		token, s, layout = nextToken(layout)
+		switch token {
+		case tokenMillis:
+			hasMillis = true
+		case tokenFrames, tokenFramePair, tokenParity:
+			frames = s
+		}
+	}
+	if hasMillis && frames != "" {
+		return fmt.Errorf("layout combines mmm with %s: %w", frames, ErrInvalidLayout)
+	}
+	return nil
+}
+
+// FormatLayout returns Timecode formatted by layout.
+// e.g. FormatLayout("HHMMSSFF") returns 01234528
+func (tc *Timecode) FormatLayout(layout string) string {
+	return string(tc.AppendLayout(make([]byte, 0, len(layout)+4), layout))
+}
+
+// AppendLayout appends Timecode formatted by layout to b and returns the extended buffer.
+// If layout is invalid, FF, ff and P are formatted as "%!FF(mmm)" and so on, as fmt does for bad verbs.
+func (tc *Timecode) AppendLayout(b []byte, layout string) []byte {
+	if tc.Negative {
+		b = append(b, '-')
+	}
+	hh, mm, ss, ms := tc.HH, tc.MM, tc.SS, uint64(0)
+	invalid := ValidateLayout(layout) != nil
+	if !invalid && strings.Contains(layout, "mmm") {
+		d := uint64(tc.r.duration(tc.Frames()) / time.Millisecond)
+		hh, mm, ss, ms = d/3600000, d/60000%60, d/1000%60, d%1000
+	}
+	for layout != "" {
+		var token layoutToken
+		var s string
+		token, s, layout = nextToken(layout)
+		switch token {
+		case tokenHours:
+			b = appendPadded(b, hh)
+		case tokenMinutes:
+			b = appendPadded(b, mm)
+		case tokenSeconds:
+			b = appendPadded(b, ss)
+		case tokenFrames, tokenFramePair, tokenParity:
+			if invalid {
+				b = append(b, "%!"+s+"(mmm)"...)
+				continue
+			}
+			switch token {
+			case tokenFrames:
+				b = appendPadded(b, tc.FF)
+			case tokenFramePair:
+				b = appendPadded(b, tc.FF/2)
+			default:
+				b = strconv.AppendUint(b, tc.FF%2+1, 10)
+			}
+		case tokenMillis:
+			if ms < 100 {
+				b = append(b, '0')
+			}
+			b = appendPadded(b, ms)
+		case tokenTotalFrames:
+			b = strconv.AppendUint(b, tc.Frames(), 10)
+		default:
+			b = append(b, s...)
+		}
+	}
+	return b
+}
+
+// parseLayout returns new Timecode from string formatted by layout.
+func parseLayout(s string, r *rate, p ParseTimecodeOptionParam) (*Timecode, error) {
+	if err := ValidateLayout(p.Layout); err != nil {
+		return nil, err
+	}
+	sc := &scanner{input: s}
+	negative := p.Signed && sc.sign()
+
+	var hh, mm, ss, ff, pair, parity, ms, frames uint64
+	var hhOffset, mmOffset, ssOffset, ffOffset int
+	var hasMillis, hasPair, hasFrames bool
+	layout := p.Layout
+	for layout != "" {
+		var token layoutToken
+		var lit string
+		token, lit, layout = nextToken(layout)
+
+		if token == tokenLiteral {
+			if !strings.HasPrefix(s[sc.pos:], lit) {
+				return nil, sc.errorf("literal", sc.pos, "does not match "+strconv.Quote(lit))
+			}
+			sc.pos += len(lit)
+			continue
+		}
+
+		field, minWidth, maxWidth := token.String(), 2, 2
+		switch token {
+		case tokenHours:
+			if p.Extended {
+				maxWidth = 4
+			}
+		case tokenFrames:
+			maxWidth = maxFramesWidth(r)
+		case tokenMillis:
+			minWidth, maxWidth = 3, 3
+		case tokenParity:
+			minWidth, maxWidth = 1, 1
+		case tokenTotalFrames:
+			minWidth, maxWidth = 1, 20
+		}
+
+		offset := sc.pos
+		v, err := sc.digits(field, minWidth, maxWidth)
+		if err != nil {
+			return nil, err
+		}
+
+		switch token {
+		case tokenHours:
+			hh, hhOffset = v, offset
+		case tokenMinutes:
+			mm, mmOffset = v, offset
+		case tokenSeconds:
+			ss, ssOffset = v, offset
+		case tokenFrames:
+			ff, ffOffset = v, offset
+		case tokenMillis:
+			ms, ffOffset, hasMillis = v, offset, true
+		case tokenFramePair:
+			pair, ffOffset, hasPair = v, offset, true
+		case tokenParity:
+			if v != 1 && v != 2 {
+				return nil, sc.errorf(field, offset, "is out of range")
+			}
+			parity = v - 1
+		case tokenTotalFrames:
+			if v > math.MaxInt64 {
+				return nil, sc.errorf(field, offset, "is out of range")
+			}
+			frames, hasFrames = v, true
+		}
+	}
+	if err := sc.end("input"); err != nil {
+		return nil, err
+	}
+
+	if hasFrames {
+		return newFramesTimecode(negative, frames, r, p)
+	}
+
+	if hasPair {
+		ff = pair*2 + parity
+	}
+	if !p.Extended && hh >= 24 {
+		return nil, sc.errorf("hours", hhOffset, "is out of range")
+	}
+	if mm > 59 {
+		return nil, sc.errorf("minutes", mmOffset, "is out of range")
+	}
+	if ss > 59 {
+		return nil, sc.errorf("seconds", ssOffset, "is out of range")
+	}
+	if hasMillis {
+		// real time to the nearest frame
+		d := time.Duration(hh)*time.Hour + time.Duration(mm)*time.Minute + time.Duration(ss)*time.Second + time.Duration(ms)*time.Millisecond
+		if negative {
+			d = -d
+		}
+		return fromDuration(d, r, TimecodeOptionParam{
+			PreferDF: p.PreferDF,
+			Sep:      ":",
+			LastSep:  ":",
+			Rounding: RoundNearest,
+			Signed:   p.Signed,
+			Extended: p.Extended,
+		})
+	}
+	if ff >= uint64(r.roundFPS) {
+		return nil, sc.errorf("frames", ffOffset, "is out of range")
+	}
+	if err := sc.checkDropped(hh, mm, ss, ff, ffOffset, r, p.Mode); err != nil {
+		return nil, err
+	}
+	return newParsedTimecode(negative, hh, mm, ss, ff, ":", ":", r, p), nil
+}
+
+// Format implements fmt.Formatter.
+//
+//	%v, %s  timecode, e.g. 01:00:00;00
+//	%+v     timecode with frame rate, e.g. 01:00:00;00@29.97DF
+//	%#v     Go-syntax representation of exported fields and frame rate
+//	%q      quoted timecode
+//	%d      signed number of frames
+//
+// Flags and width are applied as with strings and integers.
+// The zero Timecode is formatted as nil.
+func (tc Timecode) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's', 'q':
+		if tc.IsZero() {
+			fmt.Fprintf(f, formatDirective(f, verb), nil)
+			return
+		}
+		if verb == 'v' && f.Flag('#') {
+			fmt.Fprintf(f, "timecode.Timecode{Negative:%t, HH:%d, MM:%d, SS:%d, FF:%d, FrameRate:%#v}", tc.Negative, tc.HH, tc.MM, tc.SS, tc.FF, tc.FrameRate())
+			return
+		}
+		s := tc.String()
+		if verb == 'v' && f.Flag('+') {
+			s += "@" + tc.FrameRate().String()
+		}
+		if verb == 'v' {
+			verb = 's'
+		}
+		fmt.Fprintf(f, formatDirective(f, verb), s)
+	case 'd':
+		if tc.IsZero() {
+			fmt.Fprint(f, "%!d(<nil>)")
+			return
+		}
+		fmt.Fprintf(f, formatDirective(f, verb), tc.SignedFrames())
+	default:
+		if tc.IsZero() {
+			fmt.Fprintf(f, "%%!%c(timecode.Timecode=<nil>)", verb)
+			return
+		}
+		fmt.Fprintf(f, "%%!%c(timecode.Timecode=%s)", verb, tc.String())
+	}
+}
+
+// formatDirective returns format directive of verb with flags, width and precision of f.
+func formatDirective(f fmt.State, verb rune) string {
+	b := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) && !(flag == '#' && verb != 'q') && !(flag == '+' && verb == 's') {
+			b = append(b, byte(flag))
+		}
+	}
+	if w, ok := f.Width(); ok {
+		b = strconv.AppendInt(b, int64(w), 10)
+	}
+	if p, ok := f.Precision(); ok {
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(p), 10)
+	}
+	return string(append(b, byte(verb)))
+}
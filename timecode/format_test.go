@@ -0,0 +1,226 @@
+package timecode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatLayout(t *testing.T) {
+	t.Run("layouts", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:23:45:12", 25, 1)
+		assert.Equal(t, "01:23:45:12", tc.FormatLayout(LayoutDefault))
+		assert.Equal(t, "01234512", tc.FormatLayout(LayoutCompact))
+		assert.Equal(t, "01:23:45.480", tc.FormatLayout(LayoutSubtitle))
+		assert.Equal(t, "125637", tc.FormatLayout(LayoutFrames))
+		assert.Equal(t, "01:23:45:06.1", tc.FormatLayout(LayoutField))
+		assert.Equal(t, "TC 01h23m45s (frame 125637)", tc.FormatLayout("TC HHhMMmSSs (frame #)"))
+	})
+	t.Run("milliseconds", func(t *testing.T) {
+		tc, _ := ParseTimecode("00:00:01:01", 30, 1)
+		assert.Equal(t, "00:00:01.033", tc.FormatLayout(LayoutSubtitle))
+		tc, _ = ParseTimecode("00:00:01:00", 30, 1)
+		assert.Equal(t, "00:00:01.000", tc.FormatLayout(LayoutSubtitle))
+		tc, _ = ParseTimecode("00:00:01:119", 120, 1)
+		assert.Equal(t, "00:00:01.991", tc.FormatLayout(LayoutSubtitle))
+
+		// real time, not label
+		tc, _ = ParseTimecode("01:00:00:00", 30000, 1001, func(p *ParseTimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.Equal(t, "01:00:03.600", tc.FormatLayout(LayoutSubtitle))
+		tc, _ = ParseTimecode("01:00:00:00", 24000, 1001)
+		assert.Equal(t, "01:00:03.600", tc.FormatLayout(LayoutSubtitle))
+		tc, _ = ParseTimecode("01:00:00;00", 30000, 1001)
+		assert.Equal(t, "00:59:59.996", tc.FormatLayout(LayoutSubtitle))
+	})
+	t.Run("field", func(t *testing.T) {
+		tc, _ := ParseTimecode("00:00:10:59", 60000, 1001)
+		assert.Equal(t, "00:00:10:29.2", tc.FormatLayout(LayoutField))
+	})
+	t.Run("negative", func(t *testing.T) {
+		tc, _ := ParseTimecode("-00:00:01:00", 25, 1, func(p *ParseTimecodeOptionParam) {
+			p.Signed = true
+		})
+		assert.Equal(t, "-00000100", tc.FormatLayout(LayoutCompact))
+		assert.Equal(t, "-25", tc.FormatLayout(LayoutFrames))
+	})
+	t.Run("p separator", func(t *testing.T) {
+		tc, _ := ParseTimecode("01p02p03:04", 25, 1)
+		s := tc.FormatLayout("HHpMMpSS:FF")
+		assert.Equal(t, "01p02p03:04", s)
+		parsed, err := ParseTimecode(s, 25, 1, func(p *ParseTimecodeOptionParam) {
+			p.Layout = "HHpMMpSS:FF"
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, tc.Frames(), parsed.Frames())
+	})
+	t.Run("invalid layout", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00:00", 30000, 1001, func(p *ParseTimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.Equal(t, "01:00:00:%!FF(mmm) 000", tc.FormatLayout("HH:MM:SS:FF mmm"))
+		assert.Equal(t, "01:00:00:%!ff(mmm).%!P(mmm) 000", tc.FormatLayout("HH:MM:SS:ff.P mmm"))
+	})
+	t.Run("AppendLayout", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00:00", 30000, 1001)
+		assert.Equal(t, "clip_01000000.mov", string(tc.AppendLayout([]byte("clip_"), LayoutCompact+".mov")))
+	})
+}
+
+func TestValidateLayout(t *testing.T) {
+	for _, l := range []string{LayoutDefault, LayoutCompact, LayoutSubtitle, LayoutFrames, LayoutField, "HHpMMpSSpFF", ""} {
+		assert.NoError(t, ValidateLayout(l), l)
+	}
+	for _, l := range []string{"HH:MM:SS:FF mmm", "SS.mmm ff", "mmmP"} {
+		assert.ErrorIs(t, ValidateLayout(l), ErrInvalidLayout, l)
+
+		_, err := ParseTimecode("", 25, 1, func(p *ParseTimecodeOptionParam) {
+			p.Layout = l
+		})
+		assert.ErrorIs(t, err, ErrInvalidLayout, l)
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	layout := func(layout string) ParseTimecodeOption {
+		return func(p *ParseTimecodeOptionParam) {
+			p.Layout = layout
+		}
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, fr := range []FrameRate{Rate24, Rate25, Rate29_97DF, Rate29_97NDF, Rate59_94DF, Rate120} {
+			for _, l := range []string{LayoutDefault, LayoutCompact, LayoutFrames, LayoutField, "HH-MM-SS-FF"} {
+				for frames := uint64(0); frames < 5000000; frames += 99991 {
+					tc, err := NewTimecodeWithRate(frames, fr)
+					if err != nil {
+						continue
+					}
+					s := tc.FormatLayout(l)
+					parsed, err := ParseTimecodeWithRate(s, fr, layout(l))
+					assert.NoError(t, err, s)
+					assert.Equal(t, frames, parsed.Frames(), s)
+				}
+			}
+		}
+	})
+	t.Run("subtitle", func(t *testing.T) {
+		for ff := uint64(0); ff < 30; ff++ {
+			tc, _ := NewTimecode(30+ff, 30, 1)
+			parsed, err := ParseTimecode(tc.FormatLayout(LayoutSubtitle), 30, 1, layout(LayoutSubtitle))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Frames(), parsed.Frames())
+		}
+
+		tc, err := ParseTimecode("00:00:01.999", 30, 1, layout(LayoutSubtitle))
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:02:00", tc.String())
+
+		// real time of 29.97NDF is 3.6 seconds behind the label per hour
+		tc, err = ParseTimecode("01:00:03.600", 30000, 1001, layout(LayoutSubtitle), func(p *ParseTimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", tc.String())
+
+		for _, fr := range []FrameRate{Rate23_976, Rate29_97NDF, Rate29_97DF, Rate59_94NDF} {
+			for frames := uint64(0); frames < 2000000; frames += 99991 {
+				tc, _ := NewTimecodeWithRate(frames, fr)
+				s := tc.FormatLayout(LayoutSubtitle)
+				parsed, err := ParseTimecodeWithRate(s, fr, layout(LayoutSubtitle))
+				assert.NoError(t, err, s)
+				assert.Equal(t, frames, parsed.Frames(), s)
+			}
+		}
+	})
+	t.Run("frames", func(t *testing.T) {
+		tc, err := ParseTimecode("107892", 30000, 1001, layout(LayoutFrames))
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", tc.String())
+
+		tc, err = ParseTimecode("-25", 25, 1, layout(LayoutFrames), func(p *ParseTimecodeOptionParam) {
+			p.Signed = true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-25), tc.SignedFrames())
+	})
+	t.Run("extended", func(t *testing.T) {
+		tc, err := ParseTimecode("100:00:00:00", 25, 1, layout(LayoutDefault), func(p *ParseTimecodeOptionParam) {
+			p.Extended = true
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), tc.HH)
+	})
+	t.Run("error", func(t *testing.T) {
+		for _, tt := range []struct {
+			s      string
+			layout string
+		}{
+			{"0100000", LayoutCompact},
+			{"010000000", LayoutCompact},
+			{"01:00:00;00", LayoutDefault},
+			{"01:60:00:00", LayoutDefault},
+			{"24:00:00:00", LayoutDefault},
+			{"01:00:00:30", LayoutDefault},
+			{"01:00:00:10.3", LayoutField},
+			{"01:00:00.50", LayoutSubtitle},
+			{"01:00:00:10.1", "HH:MM:SS:ff.p"},
+			{"", LayoutFrames},
+			{"-25", LayoutFrames},
+			{"99999999999999999999", LayoutFrames},
+		} {
+			_, err := ParseTimecode(tt.s, 30, 1, layout(tt.layout))
+			assert.Error(t, err, tt.s)
+		}
+	})
+}
+
+func TestFormatter(t *testing.T) {
+	tc, _ := ParseTimecode("01:00:00;00", 30000, 1001, func(p *ParseTimecodeOptionParam) {
+		p.Signed = true
+	})
+	neg, _ := ParseTimecode("-00:00:01:00", 25, 1, func(p *ParseTimecodeOptionParam) {
+		p.Signed = true
+	})
+
+	for _, tt := range []struct {
+		format string
+		tc     *Timecode
+		want   string
+	}{
+		{"%v", tc, "01:00:00;00"},
+		{"%s", tc, "01:00:00;00"},
+		{"%+v", tc, "01:00:00;00@29.97DF"},
+		{"%#v", tc, "timecode.Timecode{Negative:false, HH:1, MM:0, SS:0, FF:0, FrameRate:timecode.FrameRate{Numerator:30000, Denominator:1001, DropFrame:true}}"},
+		{"%q", tc, `"01:00:00;00"`},
+		{"%d", tc, "107892"},
+		{"%14v", tc, "   01:00:00;00"},
+		{"%-14s|", tc, "01:00:00;00   |"},
+		{"%08d", tc, "00107892"},
+		{"%+d", tc, "+107892"},
+		{"%v", neg, "-00:00:01:00"},
+		{"%+v", neg, "-00:00:01:00@25"},
+		{"%#v", neg, "timecode.Timecode{Negative:true, HH:0, MM:0, SS:1, FF:0, FrameRate:timecode.FrameRate{Numerator:25, Denominator:1, DropFrame:false}}"},
+		{"%d", neg, "-25"},
+		{"%x", tc, "%!x(timecode.Timecode=01:00:00;00)"},
+		{"%v", nil, "<nil>"},
+	} {
+		t.Run(tt.format, func(t *testing.T) {
+			assert.Equal(t, tt.want, fmt.Sprintf(tt.format, tt.tc))
+		})
+	}
+
+	t.Run("Println", func(t *testing.T) {
+		assert.Equal(t, "01:00:00;00\n", fmt.Sprintln(tc))
+	})
+	t.Run("value", func(t *testing.T) {
+		assert.Equal(t, "01:00:00;00", fmt.Sprintf("%v", *tc))
+		assert.Equal(t, "01:00:00;00@29.97DF", fmt.Sprintf("%+v", *tc))
+		assert.Equal(t, "{Timecode:01:00:00;00@29.97DF Valid:true}", fmt.Sprintf("%+v", NullTimecode{Timecode: *tc, Valid: true}))
+		assert.Equal(t, "{Timecode:<nil> Valid:false}", fmt.Sprintf("%+v", NullTimecode{}))
+		assert.Equal(t, "[-00:00:01:00 01:00:00;00]", fmt.Sprint([]Timecode{*neg, *tc}))
+		assert.Equal(t, "<nil>", fmt.Sprintf("%v", Timecode{}))
+	})
+}
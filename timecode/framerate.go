@@ -0,0 +1,174 @@
+package timecode
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FrameRate represents frame rate and whether it is drop-frame.
+type FrameRate struct {
+	Numerator   int32
+	Denominator int32
+	DropFrame   bool
+}
+
+var (
+	Rate10        = FrameRate{Numerator: 10, Denominator: 1}                         // 10
+	Rate15        = FrameRate{Numerator: 15, Denominator: 1}                         // 15
+	Rate23_976    = FrameRate{Numerator: 24000, Denominator: 1001}                   // 23.976
+	Rate24        = FrameRate{Numerator: 24, Denominator: 1}                         // 24
+	Rate25        = FrameRate{Numerator: 25, Denominator: 1}                         // 25
+	Rate29_97DF   = FrameRate{Numerator: 30000, Denominator: 1001, DropFrame: true}  // 29.97DF
+	Rate29_97NDF  = FrameRate{Numerator: 30000, Denominator: 1001}                   // 29.97NDF
+	Rate30        = FrameRate{Numerator: 30, Denominator: 1}                         // 30
+	Rate48        = FrameRate{Numerator: 48, Denominator: 1}                         // 48
+	Rate50        = FrameRate{Numerator: 50, Denominator: 1}                         // 50
+	Rate59_94DF   = FrameRate{Numerator: 60000, Denominator: 1001, DropFrame: true}  // 59.94DF
+	Rate59_94NDF  = FrameRate{Numerator: 60000, Denominator: 1001}                   // 59.94NDF
+	Rate60        = FrameRate{Numerator: 60, Denominator: 1}                         // 60
+	Rate100       = FrameRate{Numerator: 100, Denominator: 1}                        // 100
+	Rate119_88DF  = FrameRate{Numerator: 120000, Denominator: 1001, DropFrame: true} // 119.88DF
+	Rate119_88NDF = FrameRate{Numerator: 120000, Denominator: 1001}                  // 119.88NDF
+	Rate120       = FrameRate{Numerator: 120, Denominator: 1}                        // 120
+)
+
+// rate returns rate of FrameRate.
+func (fr FrameRate) rate() (*rate, error) {
+	if fr.DropFrame {
+		return newDFRate(fr.Numerator, fr.Denominator)
+	}
+	return newNDFRate(fr.Numerator, fr.Denominator)
+}
+
+// frameRate returns FrameRate of rate.
+func (r *rate) frameRate() FrameRate {
+	return FrameRate{
+		Numerator:   r.numerator,
+		Denominator: r.denominator,
+		DropFrame:   r.dropFrames != 0,
+	}
+}
+
+// IsSupported returns whether frame rate is supported.
+func (fr FrameRate) IsSupported() bool {
+	_, err := fr.rate()
+	return err == nil
+}
+
+// String returns FrameRate formatted string.
+// e.g. 23.976, 25, 29.97DF, 59.94NDF
+func (fr FrameRate) String() string {
+	if fr.Denominator <= 0 {
+		return fmt.Sprintf("%d/%d", fr.Numerator, fr.Denominator)
+	}
+
+	var s string
+	if fr.Numerator%fr.Denominator == 0 {
+		s = strconv.Itoa(int(fr.Numerator / fr.Denominator))
+	} else {
+		fps := math.Round(float64(fr.Numerator)/float64(fr.Denominator)*1000) / 1000
+		s = strconv.FormatFloat(fps, 'f', -1, 64)
+	}
+
+	if fr.DropFrame {
+		return s + "DF"
+	}
+	if _, err := newDFRate(fr.Numerator, fr.Denominator); err == nil {
+		return s + "NDF" // distinguish from DF
+	}
+	return s
+}
+
+// ParseFrameRate returns FrameRate from formatted string.
+// It accepts decimal ("29.97", "23.98"), rational ("30000/1001") and progressive ("59.94p") forms,
+// optionally followed by "DF" or "NDF". Without suffix, DF is assumed if frame rate supports DF.
+func ParseFrameRate(s string) (FrameRate, error) {
+	s = strings.TrimSpace(s)
+	upper := strings.ToUpper(s)
+
+	var explicit, df bool
+	switch {
+	case strings.HasSuffix(upper, "NDF"):
+		explicit, df = true, false
+		s = s[:len(s)-3]
+	case strings.HasSuffix(upper, "DF"):
+		explicit, df = true, true
+		s = s[:len(s)-2]
+	case strings.HasSuffix(upper, "P"):
+		s = s[:len(s)-1]
+	}
+	s = strings.TrimSpace(s)
+
+	var r *rate
+	var err error
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		num, err1 := strconv.ParseInt(s[:i], 10, 32)
+		den, err2 := strconv.ParseInt(s[i+1:], 10, 32)
+		if err1 != nil || err2 != nil {
+			return FrameRate{}, ErrInvalidFrameRate
+		}
+		r, err = newRate(int32(num), int32(den), !explicit || df)
+	} else {
+		fps, perr := strconv.ParseFloat(s, 64)
+		if perr != nil || strings.Trim(s, "0123456789.") != "" {
+			return FrameRate{}, ErrInvalidFrameRate
+		}
+		decimals := 0
+		if i := strings.IndexByte(s, '.'); i >= 0 {
+			decimals = len(s) - i - 1
+		}
+		r, err = newDecimalRate(fps, decimals, !explicit || df)
+	}
+	if err != nil {
+		return FrameRate{}, err
+	}
+	if explicit && df != (r.dropFrames != 0) {
+		return FrameRate{}, ErrUnsupportedFrameRate
+	}
+	return r.frameRate(), nil
+}
+
+// newDecimalRate returns rate matching decimal fps rounded to decimals.
+// Exact match is preferred, e.g. "30" is 30 rather than 29.97.
+func newDecimalRate(fps float64, decimals int, preferDF bool) (*rate, error) {
+	ratesMu.RLock()
+	var exact, rounded *rate
+	scale := math.Pow10(decimals)
+	for _, rates := range [][]*rate{supportedNDFRates, supportedDFRates} {
+		for _, r := range rates {
+			actual := float64(r.numerator) / float64(r.denominator)
+			if actual == fps && exact == nil {
+				exact = r
+			}
+			if math.Round(actual*scale)/scale == fps && rounded == nil {
+				rounded = r
+			}
+		}
+	}
+	ratesMu.RUnlock()
+
+	if exact == nil {
+		exact = rounded
+	}
+	if exact == nil {
+		return nil, ErrUnsupportedFrameRate
+	}
+	return newRate(exact.numerator, exact.denominator, preferDF)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (fr FrameRate) MarshalText() ([]byte, error) {
+	return []byte(fr.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (fr *FrameRate) UnmarshalText(text []byte) error {
+	parsed, err := ParseFrameRate(string(text))
+	if err != nil {
+		return err
+	}
+	*fr = parsed
+	return nil
+}
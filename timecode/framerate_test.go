@@ -0,0 +1,134 @@
+package timecode
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameRateString(t *testing.T) {
+	assert.Equal(t, "10", Rate10.String())
+	assert.Equal(t, "23.976", Rate23_976.String())
+	assert.Equal(t, "24", Rate24.String())
+	assert.Equal(t, "25", Rate25.String())
+	assert.Equal(t, "29.97DF", Rate29_97DF.String())
+	assert.Equal(t, "29.97NDF", Rate29_97NDF.String())
+	assert.Equal(t, "30", Rate30.String())
+	assert.Equal(t, "59.94DF", Rate59_94DF.String())
+	assert.Equal(t, "59.94NDF", Rate59_94NDF.String())
+	assert.Equal(t, "119.88DF", Rate119_88DF.String())
+	assert.Equal(t, "120", Rate120.String())
+	assert.Equal(t, "12.5", FrameRate{Numerator: 25, Denominator: 2}.String())
+	assert.Equal(t, "1/0", FrameRate{Numerator: 1, Denominator: 0}.String())
+}
+
+func TestParseFrameRate(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		want  FrameRate
+		err   error
+	}{
+		{name: "integer", input: "25", want: Rate25},
+		{name: "integer/exact match", input: "30", want: Rate30},
+		{name: "decimal", input: "23.976", want: Rate23_976},
+		{name: "decimal/rounded", input: "23.98", want: Rate23_976},
+		{name: "decimal/prefer DF", input: "29.97", want: Rate29_97DF},
+		{name: "decimal/DF", input: "29.97DF", want: Rate29_97DF},
+		{name: "decimal/NDF", input: "29.97NDF", want: Rate29_97NDF},
+		{name: "decimal/lower case", input: "59.94ndf", want: Rate59_94NDF},
+		{name: "progressive", input: "59.94p", want: Rate59_94DF},
+		{name: "progressive/integer", input: "50p", want: Rate50},
+		{name: "rational", input: "30000/1001", want: Rate29_97DF},
+		{name: "rational/NDF", input: "30000/1001 NDF", want: Rate29_97NDF},
+		{name: "rational/integer", input: "24/1", want: Rate24},
+		{name: "rational/non-reduced", input: "48000/2002", want: Rate23_976},
+		{name: "HFR", input: "119.88DF", want: Rate119_88DF},
+		{name: "custom", input: "96", want: FrameRate{Numerator: 96, Denominator: 1}},
+		{name: "error/unregistered", input: "12.5", err: ErrUnsupportedFrameRate},
+		{name: "error/DF", input: "25DF", err: ErrUnsupportedFrameRate},
+		{name: "error/unsupported", input: "29.98", err: ErrUnsupportedFrameRate},
+		{name: "error/unsupported rational", input: "7/1", err: ErrUnsupportedFrameRate},
+		{name: "error/empty", input: "", err: ErrInvalidFrameRate},
+		{name: "error/NaN", input: "NaN", err: ErrInvalidFrameRate},
+		{name: "error/exponent", input: "3e1", err: ErrInvalidFrameRate},
+		{name: "error/rational", input: "30000/", err: ErrInvalidFrameRate},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fr, err := ParseFrameRate(tc.input)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.want, fr)
+		})
+	}
+}
+
+func TestFrameRateRoundTrip(t *testing.T) {
+	for _, fr := range []FrameRate{
+		Rate10, Rate15, Rate23_976, Rate24, Rate25, Rate29_97DF, Rate29_97NDF, Rate30, Rate48, Rate50,
+		Rate59_94DF, Rate59_94NDF, Rate60, Rate100, Rate119_88DF, Rate119_88NDF, Rate120,
+	} {
+		assert.True(t, fr.IsSupported())
+		parsed, err := ParseFrameRate(fr.String())
+		assert.NoError(t, err)
+		assert.Equal(t, fr, parsed)
+	}
+}
+
+func TestFrameRateJSON(t *testing.T) {
+	type config struct {
+		Rate FrameRate `json:"rate"`
+	}
+	b, err := json.Marshal(config{Rate: Rate29_97NDF})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"rate":"29.97NDF"}`, string(b))
+
+	var c config
+	assert.NoError(t, json.Unmarshal([]byte(`{"rate":"59.94p"}`), &c))
+	assert.Equal(t, Rate59_94DF, c.Rate)
+
+	assert.Equal(t, ErrUnsupportedFrameRate, json.Unmarshal([]byte(`{"rate":"7"}`), &c))
+}
+
+func TestNewTimecodeWithRate(t *testing.T) {
+	t.Run("DF", func(t *testing.T) {
+		tc, err := NewTimecodeWithRate(1800, Rate29_97DF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:02", tc.String())
+		assert.Equal(t, Rate29_97DF, tc.FrameRate())
+	})
+	t.Run("NDF", func(t *testing.T) {
+		tc, err := NewTimecodeWithRate(1800, Rate29_97NDF, func(p *TimecodeOptionParam) {
+			p.PreferDF = true // ignored
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", tc.String())
+		assert.Equal(t, Rate29_97NDF, tc.FrameRate())
+	})
+	t.Run("error/unsupported DF", func(t *testing.T) {
+		tc, err := NewTimecodeWithRate(1800, FrameRate{Numerator: 25, Denominator: 1, DropFrame: true})
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+	})
+}
+
+func TestParseTimecodeWithRate(t *testing.T) {
+	t.Run("DF", func(t *testing.T) {
+		tc, err := ParseTimecodeWithRate("00:01:00;00", Rate29_97DF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00;02", tc.String())
+		assert.Equal(t, uint64(1800), tc.Frames())
+	})
+	t.Run("NDF", func(t *testing.T) {
+		tc, err := ParseTimecodeWithRate("00:01:00;00", Rate29_97NDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", tc.String())
+		assert.Equal(t, uint64(1800), tc.Frames())
+	})
+	t.Run("error/unsupported", func(t *testing.T) {
+		tc, err := ParseTimecodeWithRate("00:01:00:00", FrameRate{Numerator: 7, Denominator: 1})
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+	})
+}
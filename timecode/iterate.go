@@ -0,0 +1,141 @@
+package timecode
+
+// IterateOptionParam represents Iterate option parameter.
+type IterateOptionParam struct {
+	Step uint64 // number of frames per step, 0 is treated as 1
+}
+
+// IterateOption represents Iterate option.
+type IterateOption func(*IterateOptionParam)
+
+// newIterateOptionParam returns new IterateOptionParam.
+func newIterateOptionParam() IterateOptionParam {
+	return IterateOptionParam{
+		Step: 1,
+	}
+}
+
+// applyIterateOption applies IterateOption to IterateOptionParam.
+func (p *IterateOptionParam) applyIterateOption(opts ...IterateOption) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// Iterate returns sequence of Timecodes from start toward end, excluding end.
+// If end is before start, it walks in reverse.
+// The sequence is compatible with iter.Seq[*Timecode], so it can be used with range over func since Go 1.23.
+// Timecodes are stepped by incrementing HH:MM:SS:FF in place, skipping dropped frames, with one allocation per run.
+// The yielded Timecode is reused within a run and only valid until the next yield, copy it to retain.
+// The sequence may be run many times, also concurrently.
+func Iterate(start, end *Timecode, opts ...IterateOption) (func(yield func(*Timecode) bool), error) {
+	p := newIterateOptionParam()
+	p.applyIterateOption(opts...)
+
+	if start == nil || end == nil {
+		return nil, ErrNilTimecode
+	}
+	if !start.r.equal(end.r) {
+		return nil, ErrMismatchFrameRate
+	}
+	step := p.Step
+	if step == 0 {
+		step = 1
+	}
+
+	forward := true
+	frames := end.SignedFrames() - start.SignedFrames()
+	if frames < 0 {
+		forward = false
+		frames = -frames
+	}
+	n := (uint64(frames) + step - 1) / step
+
+	first := *start
+	return func(yield func(*Timecode) bool) {
+		tc := new(Timecode)
+		*tc = first
+		for i := uint64(0); i < n; i++ {
+			for j := uint64(0); i > 0 && j < step; j++ {
+				if forward {
+					tc.next()
+				} else {
+					tc.prev()
+				}
+			}
+			if !yield(tc) {
+				return
+			}
+		}
+	}, nil
+}
+
+// next advances Timecode by one frame in place.
+func (tc *Timecode) next() {
+	if tc.Negative {
+		tc.decrement()
+		tc.Negative = tc.HH != 0 || tc.MM != 0 || tc.SS != 0 || tc.FF != 0
+		return
+	}
+	tc.increment()
+}
+
+// prev moves Timecode back by one frame in place.
+func (tc *Timecode) prev() {
+	if tc.Negative || tc.HH == 0 && tc.MM == 0 && tc.SS == 0 && tc.FF == 0 {
+		tc.increment()
+		tc.Negative = true
+		return
+	}
+	tc.decrement()
+}
+
+// isDropMinute returns whether frames are dropped at the beginning of the current minute.
+func (tc *Timecode) isDropMinute() bool {
+	return tc.r.dropFrames != 0 && (tc.HH*60+tc.MM)%uint64(tc.r.cycleMinutes) != 0
+}
+
+// increment increments absolute value of Timecode by one frame in place.
+func (tc *Timecode) increment() {
+	tc.FF++
+	if tc.FF < uint64(tc.r.roundFPS) {
+		return
+	}
+	tc.FF = 0
+	tc.SS++
+	if tc.SS == 60 {
+		tc.SS = 0
+		tc.MM++
+		if tc.MM == 60 {
+			tc.MM = 0
+			tc.HH++
+		}
+		if tc.isDropMinute() {
+			tc.FF = uint64(tc.r.dropFrames)
+		}
+	}
+}
+
+// decrement decrements absolute value of Timecode by one frame in place.
+func (tc *Timecode) decrement() {
+	var min uint64
+	if tc.SS == 0 && tc.isDropMinute() {
+		min = uint64(tc.r.dropFrames)
+	}
+	if tc.FF > min {
+		tc.FF--
+		return
+	}
+	tc.FF = uint64(tc.r.roundFPS) - 1
+	if tc.SS > 0 {
+		tc.SS--
+		return
+	}
+	tc.SS = 59
+	if tc.MM > 0 {
+		tc.MM--
+		return
+	}
+	tc.MM = 59
+	tc.HH--
+}
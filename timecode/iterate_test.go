@@ -0,0 +1,162 @@
+package timecode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterate(t *testing.T) {
+	collect := func(seq func(yield func(*Timecode) bool)) []string {
+		var s []string
+		seq(func(tc *Timecode) bool {
+			s = append(s, tc.String())
+			return true
+		})
+		return s
+	}
+
+	t.Run("forward", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:59:28", 30000, 1001)
+		end, _ := ParseTimecode("00:01:00:04", 30000, 1001)
+		seq, err := Iterate(start, end)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"00:00:59:28", "00:00:59:29", "00:01:00:02", "00:01:00:03"}, collect(seq))
+	})
+	t.Run("reverse", func(t *testing.T) {
+		start, _ := ParseTimecode("00:01:00:03", 30000, 1001)
+		end, _ := ParseTimecode("00:00:59:28", 30000, 1001)
+		seq, err := Iterate(start, end)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"00:01:00:03", "00:01:00:02", "00:00:59:29"}, collect(seq))
+	})
+	t.Run("fmt", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:59:28", 30000, 1001)
+		end, _ := ParseTimecode("00:00:59:29", 30000, 1001)
+		seq, _ := Iterate(start, end)
+		var s []string
+		seq(func(tc *Timecode) bool {
+			s = append(s, fmt.Sprintf("%v", tc), fmt.Sprint(tc))
+			return true
+		})
+		assert.Equal(t, []string{"00:00:59:28", "00:00:59:28"}, s)
+	})
+	t.Run("step", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:00:00", 25, 1)
+		end, _ := ParseTimecode("00:00:02:00", 25, 1)
+		seq, err := Iterate(start, end, func(p *IterateOptionParam) {
+			p.Step = 20
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"00:00:00:00", "00:00:00:20", "00:00:01:15"}, collect(seq))
+	})
+	t.Run("signed", func(t *testing.T) {
+		zero, _ := NewTimecode(0, 25, 1, func(p *TimecodeOptionParam) {
+			p.Signed = true
+		})
+		start, _ := zero.SubFrames(2)
+		end, _ := zero.AddFrames(2)
+		seq, err := Iterate(start, end)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"-00:00:00:02", "-00:00:00:01", "00:00:00:00", "00:00:00:01"}, collect(seq))
+
+		seq, err = Iterate(end, start)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"00:00:00:02", "00:00:00:01", "00:00:00:00", "-00:00:00:01"}, collect(seq))
+	})
+	t.Run("empty", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:00:00", 25, 1)
+		seq, err := Iterate(start, start)
+		assert.NoError(t, err)
+		assert.Empty(t, collect(seq))
+	})
+	t.Run("break", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:00:00", 25, 1)
+		end, _ := ParseTimecode("01:00:00:00", 25, 1)
+		seq, _ := Iterate(start, end)
+		count := 0
+		seq(func(tc *Timecode) bool {
+			count++
+			return count < 3
+		})
+		assert.Equal(t, 3, count)
+	})
+	t.Run("consistent with Reset", func(t *testing.T) {
+		for _, fr := range []FrameRate{Rate29_97DF, Rate59_94DF, Rate119_88DF, Rate25} {
+			start, _ := NewTimecodeWithRate(0, fr)
+			end, _ := NewTimecodeWithRate(uint64(mustRate(fr).framesPerCycle+5), fr)
+			seq, err := Iterate(start, end)
+			assert.NoError(t, err)
+			frames := uint64(0)
+			seq(func(tc *Timecode) bool {
+				want, _ := Reset(start, frames)
+				frames++
+				return *want == *tc || assert.Equal(t, *want, *tc)
+			})
+			assert.Equal(t, end.Frames(), frames)
+
+			seq, err = Iterate(end, start)
+			assert.NoError(t, err)
+			seq(func(tc *Timecode) bool {
+				want, _ := Reset(start, frames)
+				frames--
+				return *want == *tc || assert.Equal(t, *want, *tc)
+			})
+			assert.Equal(t, uint64(0), frames)
+		}
+	})
+	t.Run("allocation", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:00:00", 30000, 1001)
+		yield := func(tc *Timecode) bool {
+			return true
+		}
+		allocs := func(end string) float64 {
+			end2, _ := ParseTimecode(end, 30000, 1001)
+			seq, _ := Iterate(start, end2)
+			return testing.AllocsPerRun(10, func() {
+				seq(yield)
+			})
+		}
+		assert.Equal(t, allocs("00:00:00:10"), allocs("00:10:00:00"))
+	})
+	t.Run("nested", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:00:00", 25, 1)
+		end, _ := ParseTimecode("00:00:00:03", 25, 1)
+		seq, _ := Iterate(start, end)
+		var got []string
+		seq(func(a *Timecode) bool {
+			seq(func(b *Timecode) bool {
+				got = append(got, a.String()+"/"+b.String())
+				return true
+			})
+			return true
+		})
+		assert.Equal(t, []string{
+			"00:00:00:00/00:00:00:00", "00:00:00:00/00:00:00:01", "00:00:00:00/00:00:00:02",
+			"00:00:00:01/00:00:00:00", "00:00:00:01/00:00:00:01", "00:00:00:01/00:00:00:02",
+			"00:00:00:02/00:00:00:00", "00:00:00:02/00:00:00:01", "00:00:00:02/00:00:00:02",
+		}, got)
+	})
+	t.Run("error/mismatch frame rate", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:00:00", 25, 1)
+		end, _ := ParseTimecode("00:00:01:00", 24, 1)
+		seq, err := Iterate(start, end)
+		assert.Nil(t, seq)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+	})
+	t.Run("error/nil", func(t *testing.T) {
+		start, _ := ParseTimecode("00:00:00:00", 25, 1)
+		seq, err := Iterate(start, nil)
+		assert.Nil(t, seq)
+		assert.Equal(t, ErrNilTimecode, err)
+	})
+}
+
+func mustRate(fr FrameRate) *rate {
+	r, err := fr.rate()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
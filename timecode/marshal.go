@@ -0,0 +1,153 @@
+package timecode
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// IsZero reports whether tc is the zero Timecode, which has no frame rate.
+// Note that 00:00:00:00 with frame rate is not zero.
+func (tc Timecode) IsZero() bool {
+	return tc.r == nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The text is canonical timecode followed by "@" and frame rate, e.g. 01:00:00;00@30000/1001.
+// Canonical timecode is separated by ":", and by ";" before frames if DF.
+// The zero Timecode is encoded as empty text.
+func (tc Timecode) MarshalText() ([]byte, error) {
+	if tc.IsZero() {
+		return []byte{}, nil
+	}
+	return tc.appendText(make([]byte, 0, 24)), nil
+}
+
+// appendText appends canonical text of Timecode to b.
+func (tc Timecode) appendText(b []byte) []byte {
+	if tc.Negative {
+		b = append(b, '-')
+	}
+	b = appendPadded(b, tc.HH)
+	b = append(b, ':')
+	b = appendPadded(b, tc.MM)
+	b = append(b, ':')
+	b = appendPadded(b, tc.SS)
+	if tc.r.dropFrames != 0 {
+		b = append(b, ';')
+	} else {
+		b = append(b, ':')
+	}
+	b = appendPadded(b, tc.FF)
+	b = append(b, '@')
+	b = strconv.AppendInt(b, int64(tc.r.numerator), 10)
+	b = append(b, '/')
+	return strconv.AppendInt(b, int64(tc.r.denominator), 10)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// DF is inferred from the last separator unless frame rate has "DF" or "NDF" suffix, e.g. 01:00:00;00@29.97DF.
+// Signed is set for negative timecode, and Extended for 24 hours and more.
+// Empty text is decoded as the zero Timecode.
+func (tc *Timecode) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*tc = Timecode{}
+		return nil
+	}
+	s := string(text)
+	i := strings.LastIndexByte(s, '@')
+	if i < 0 {
+		return &ParseError{Input: s, Field: "frame rate", Offset: len(s), Reason: "is missing", Err: ErrInvalidTimecode}
+	}
+	fr, err := ParseFrameRate(s[i+1:])
+	if err != nil {
+		return err
+	}
+
+	parsed, err := parseText(s[:i], fr, strings.HasSuffix(strings.ToUpper(s[i+1:]), "DF"))
+	if err != nil {
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = s
+		}
+		return err
+	}
+	*tc = *parsed
+	return nil
+}
+
+// parseText returns new Timecode from canonical timecode.
+func parseText(s string, fr FrameRate, fixedRate bool) (*Timecode, error) {
+	hours := strings.TrimPrefix(s, "-")
+	if i := strings.IndexAny(hours, "p:;.,"); i >= 0 {
+		hours = hours[:i]
+	}
+	hh, _ := strconv.Atoi(hours)
+	opt := func(p *ParseTimecodeOptionParam) {
+		p.DetectDF = true
+		p.Signed = strings.HasPrefix(s, "-")
+		p.Extended = len(hours) > 2 || hh >= 24
+	}
+	if fixedRate {
+		return ParseTimecodeWithRate(s, fr, opt)
+	}
+	return ParseTimecode(s, fr.Numerator, fr.Denominator, opt)
+}
+
+// jsonTimecode represents object form of Timecode in JSON.
+type jsonTimecode struct {
+	Timecode string `json:"timecode"`
+	Rate     struct {
+		Num int32 `json:"num"`
+		Den int32 `json:"den"`
+	} `json:"rate"`
+	DF *bool `json:"df,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// Timecode is encoded as string of MarshalText, and the zero Timecode as null.
+// Object form accepted by UnmarshalJSON is never produced.
+func (tc Timecode) MarshalJSON() ([]byte, error) {
+	if tc.IsZero() {
+		return []byte("null"), nil
+	}
+	text, err := tc.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts string of MarshalText and object form, e.g.
+// {"timecode": "01:00:00;00", "rate": {"num": 30000, "den": 1001}, "df": true}.
+// In object form, DF is inferred from the last separator if "df" is omitted.
+// Object form is accepted on input only, MarshalJSON always produces string.
+func (tc *Timecode) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) != 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return tc.UnmarshalText([]byte(s))
+	}
+
+	var obj jsonTimecode
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	fr := FrameRate{Numerator: obj.Rate.Num, Denominator: obj.Rate.Den}
+	if obj.DF != nil {
+		fr.DropFrame = *obj.DF
+	}
+	parsed, err := parseText(obj.Timecode, fr, obj.DF != nil)
+	if err != nil {
+		return err
+	}
+	*tc = *parsed
+	return nil
+}
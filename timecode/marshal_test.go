@@ -0,0 +1,167 @@
+package timecode
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalText(t *testing.T) {
+	t.Run("MarshalText", func(t *testing.T) {
+		for _, tt := range []struct {
+			s    string
+			num  int32
+			den  int32
+			opts []ParseTimecodeOption
+			want string
+		}{
+			{"01:00:00;00", 30000, 1001, nil, "01:00:00;00@30000/1001"},
+			{"01:00:00.00", 30000, 1001, nil, "01:00:00;00@30000/1001"},
+			{"01:00:00:00", 30000, 1001, nil, "01:00:00;00@30000/1001"},
+			{"01:00:00:00", 30000, 1001, []ParseTimecodeOption{func(p *ParseTimecodeOptionParam) { p.PreferDF = false }}, "01:00:00:00@30000/1001"},
+			{"01:00:00:00", 25, 1, nil, "01:00:00:00@25/1"},
+			{"00:00:01:119", 120, 1, nil, "00:00:01:119@120/1"},
+			{"-00:00:01:00", 25, 1, []ParseTimecodeOption{func(p *ParseTimecodeOptionParam) { p.Signed = true }}, "-00:00:01:00@25/1"},
+			{"100:00:00:00", 25, 1, []ParseTimecodeOption{func(p *ParseTimecodeOptionParam) { p.Extended = true }}, "100:00:00:00@25/1"},
+		} {
+			tc, err := ParseTimecode(tt.s, tt.num, tt.den, tt.opts...)
+			assert.NoError(t, err)
+			text, err := tc.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(text))
+
+			var parsed Timecode
+			assert.NoError(t, parsed.UnmarshalText(text))
+			assert.Equal(t, tc.SignedFrames(), parsed.SignedFrames(), tt.want)
+			assert.Equal(t, tc.FrameRate(), parsed.FrameRate(), tt.want)
+			assert.Equal(t, tc.Negative, parsed.signed)
+			assert.Equal(t, tc.HH >= 24, parsed.extended)
+		}
+	})
+	t.Run("MarshalText/zero value", func(t *testing.T) {
+		assert.True(t, Timecode{}.IsZero())
+		text, err := Timecode{}.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "", string(text))
+
+		tc, _ := ParseTimecode("01:00:00:00", 25, 1)
+		assert.NoError(t, tc.UnmarshalText(text))
+		assert.True(t, tc.IsZero())
+
+		tc, _ = ParseTimecode("00:00:00:00", 25, 1)
+		assert.False(t, tc.IsZero())
+	})
+	t.Run("UnmarshalText", func(t *testing.T) {
+		for _, tt := range []struct {
+			s      string
+			want   FrameRate
+			frames uint64
+		}{
+			{"01:00:00;00@30000/1001", Rate29_97DF, 107892},
+			{"01:00:00:00@30000/1001", Rate29_97NDF, 108000},
+			{"01:00:00;00@29.97", Rate29_97DF, 107892},
+			{"01:00:00;00@29.97DF", Rate29_97DF, 107892},
+			{"01:00:00:00@29.97NDF", Rate29_97NDF, 108000},
+			{"00:01:00;04@60000/1001", Rate59_94DF, 3600},
+			{"01:00:00:00@25", Rate25, 90000},
+		} {
+			var tc Timecode
+			assert.NoError(t, tc.UnmarshalText([]byte(tt.s)), tt.s)
+			assert.Equal(t, tt.want, tc.FrameRate(), tt.s)
+			assert.Equal(t, tt.frames, tc.Frames(), tt.s)
+		}
+	})
+	t.Run("UnmarshalText/error", func(t *testing.T) {
+		var tc Timecode
+		err := tc.UnmarshalText([]byte("01:00:00:00"))
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+
+		err = tc.UnmarshalText([]byte("01:00:00:00@1/1"))
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+
+		err = tc.UnmarshalText([]byte("01:60:00:00@25/1"))
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr))
+		assert.Equal(t, "01:60:00:00@25/1", perr.Input)
+		assert.Equal(t, 3, perr.Offset)
+
+		err = tc.UnmarshalText([]byte("01:00:00:00@29.97DF"))
+		assert.ErrorIs(t, err, ErrMismatchFrameRate)
+	})
+}
+
+func TestMarshalJSON(t *testing.T) {
+	type clip struct {
+		In  Timecode  `json:"in"`
+		Out *Timecode `json:"out"`
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		in, _ := ParseTimecode("01:00:00;00", 30000, 1001)
+		out, _ := ParseTimecode("01:00:10;00", 30000, 1001)
+		b, err := json.Marshal(clip{In: *in, Out: out})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"in":"01:00:00;00@30000/1001","out":"01:00:10;00@30000/1001"}`, string(b))
+
+		var c clip
+		assert.NoError(t, json.Unmarshal(b, &c))
+		assert.Equal(t, in.Frames(), c.In.Frames())
+		assert.Equal(t, out.Frames(), c.Out.Frames())
+		assert.Equal(t, Rate29_97DF, c.Out.FrameRate())
+	})
+	t.Run("object", func(t *testing.T) {
+		for _, tt := range []struct {
+			s      string
+			want   FrameRate
+			frames uint64
+		}{
+			{`{"timecode": "01:00:00;00", "rate": {"num": 30000, "den": 1001}, "df": true}`, Rate29_97DF, 107892},
+			{`{"timecode": "01:00:00:00", "rate": {"num": 30000, "den": 1001}, "df": false}`, Rate29_97NDF, 108000},
+			{`{"timecode": "01:00:00;00", "rate": {"num": 30000, "den": 1001}}`, Rate29_97DF, 107892},
+			{`{"timecode": "01:00:00:00", "rate": {"num": 30000, "den": 1001}}`, Rate29_97NDF, 108000},
+			{`{"timecode": "01:00:00:00", "rate": {"num": 25, "den": 1}}`, Rate25, 90000},
+		} {
+			var tc Timecode
+			assert.NoError(t, json.Unmarshal([]byte(tt.s), &tc), tt.s)
+			assert.Equal(t, tt.want, tc.FrameRate(), tt.s)
+			assert.Equal(t, tt.frames, tc.Frames(), tt.s)
+		}
+	})
+	t.Run("null", func(t *testing.T) {
+		var c clip
+		assert.NoError(t, json.Unmarshal([]byte(`{"in":"00:00:01:00@25/1","out":null}`), &c))
+		assert.Equal(t, uint64(25), c.In.Frames())
+		assert.Nil(t, c.Out)
+
+		b, err := json.Marshal(c)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"in":"00:00:01:00@25/1","out":null}`, string(b))
+	})
+	t.Run("zero value", func(t *testing.T) {
+		b, err := json.Marshal(clip{})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"in":null,"out":null}`, string(b))
+
+		var c clip
+		assert.NoError(t, json.Unmarshal(b, &c))
+		assert.True(t, c.In.IsZero())
+		assert.Nil(t, c.Out)
+	})
+	t.Run("error", func(t *testing.T) {
+		var tc Timecode
+		err := json.Unmarshal([]byte(`{"timecode": "01:00:00:00", "rate": {"num": 30000, "den": 1001}, "df": true}`), &tc)
+		assert.ErrorIs(t, err, ErrMismatchFrameRate)
+
+		err = json.Unmarshal([]byte(`{"timecode": "01:00:00:00", "rate": {"num": 1, "den": 1}}`), &tc)
+		assert.ErrorIs(t, err, ErrUnsupportedFrameRate)
+
+		err = json.Unmarshal([]byte(`"01:00:00:00"`), &tc)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+
+		err = json.Unmarshal([]byte(`123`), &tc)
+		assert.Error(t, err)
+
+	})
+}
@@ -0,0 +1,302 @@
+package timecode
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseMode represents treatment of labels which do not exist at the frame rate.
+type ParseMode int
+
+const (
+	// ParseLenient accepts frames up to 59 regardless of the frame rate,
+	// and coerces labels dropped by drop-frame to the next label, e.g. 00:01:00;00 to 00:01:00;02.
+	ParseLenient ParseMode = iota
+	// ParseStrict rejects frames of the frame rate or more,
+	// and labels dropped by drop-frame with ParseError wrapping ErrDroppedFrame.
+	ParseStrict
+)
+
+// ParseError represents error of parsing timecode.
+// It wraps ErrInvalidTimecode, so errors.Is(err, ErrInvalidTimecode) reports true.
+type ParseError struct {
+	Input  string // string being parsed
+	Field  string // offending field, e.g. "minutes"
+	Offset int    // byte offset of the offending field in Input
+	Reason string // description of the failure, e.g. "out of range"
+	Err    error  // underlying error
+}
+
+// Error implements error.
+func (e *ParseError) Error() string {
+	return "parse timecode " + strconv.Quote(e.Input) + ": " + e.Field + " " + e.Reason + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// Unwrap returns underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrInvalidTimecode.
+// Err is also matched through Unwrap, e.g. ErrDroppedFrame.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidTimecode
+}
+
+// scanner represents cursor over string being parsed.
+type scanner struct {
+	input string
+	pos   int
+}
+
+// errorf returns ParseError of field at offset.
+func (sc *scanner) errorf(field string, offset int, reason string) *ParseError {
+	return &ParseError{
+		Input:  sc.input,
+		Field:  field,
+		Offset: offset,
+		Reason: reason,
+		Err:    ErrInvalidTimecode,
+	}
+}
+
+// checkDropped returns error if label is dropped by drop-frame in ParseStrict mode.
+func (sc *scanner) checkDropped(hh, mm, ss, ff uint64, offset int, r *rate, mode ParseMode) error {
+	if mode == ParseStrict && r.isDropped(hh, mm, ss, ff) {
+		err := sc.errorf("frames", offset, "is dropped by drop-frame")
+		err.Err = ErrDroppedFrame
+		return err
+	}
+	return nil
+}
+
+// sign consumes "-" and reports whether it is consumed.
+func (sc *scanner) sign() bool {
+	if sc.pos < len(sc.input) && sc.input[sc.pos] == '-' {
+		sc.pos++
+		return true
+	}
+	return false
+}
+
+// digits consumes from min up to max digits of field and returns value of them.
+func (sc *scanner) digits(field string, min, max int) (uint64, error) {
+	start := sc.pos
+	for sc.pos < len(sc.input) && sc.pos-start < max && isDigit(sc.input[sc.pos]) {
+		sc.pos++
+	}
+	if sc.pos-start < min {
+		switch {
+		case sc.pos == len(sc.input) && sc.pos == start:
+			return 0, sc.errorf(field, start, "is missing")
+		case sc.pos == len(sc.input) || strings.IndexByte("p:;.,", sc.input[sc.pos]) >= 0:
+			return 0, sc.errorf(field, start, "has too few digits")
+		default:
+			return 0, sc.errorf(field, sc.pos, "has invalid character "+strconv.QuoteRune(rune(sc.input[sc.pos])))
+		}
+	}
+	v, err := strconv.ParseUint(sc.input[start:sc.pos], 10, 64)
+	if err != nil {
+		return 0, sc.errorf(field, start, "is out of range")
+	}
+	return v, nil
+}
+
+// separator consumes one of seps and returns it.
+func (sc *scanner) separator(field string, seps string) (string, error) {
+	if sc.pos == len(sc.input) {
+		return "", sc.errorf(field, sc.pos, "is missing")
+	}
+	c := sc.input[sc.pos]
+	for i := 0; i < len(seps); i++ {
+		if seps[i] == c {
+			sc.pos++
+			return sc.input[sc.pos-1 : sc.pos], nil
+		}
+	}
+	if isDigit(c) {
+		return "", sc.errorf(field, sc.pos, "is missing")
+	}
+	return "", sc.errorf(field, sc.pos, "has invalid character "+strconv.QuoteRune(rune(c)))
+}
+
+// end returns error if there are remaining characters.
+func (sc *scanner) end(field string) error {
+	if sc.pos < len(sc.input) {
+		if isDigit(sc.input[sc.pos]) {
+			return sc.errorf(field, sc.pos, "has too many digits")
+		}
+		return sc.errorf(field, sc.pos, "is followed by unexpected "+strconv.QuoteRune(rune(sc.input[sc.pos])))
+	}
+	return nil
+}
+
+// detectRate returns DF or NDF rate of r indicated by lastSep.
+// ok is false if r does not have both DF and NDF.
+func detectRate(r *rate, lastSep string) (detected *rate, ok bool) {
+	df, err := newDFRate(r.numerator, r.denominator)
+	if err != nil {
+		return nil, false
+	}
+	ndf, err := newNDFRate(r.numerator, r.denominator)
+	if err != nil {
+		return nil, false
+	}
+	if lastSep == ":" {
+		return ndf, true
+	}
+	return df, true
+}
+
+// maxFramesWidth returns maximum number of digits of frames, which is 3 only over 100fps.
+func maxFramesWidth(r *rate) int {
+	if r.roundFPS > 100 {
+		return 3
+	}
+	return 2
+}
+
+// suffix consumes c at the end, and returns error if there are other remaining characters.
+func (sc *scanner) suffix(field string, c byte) error {
+	if sc.pos == len(sc.input)-1 && sc.input[sc.pos] == c {
+		sc.pos++
+		return nil
+	}
+	return sc.end(field)
+}
+
+// isDigit reports whether c is ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// parseTimecode returns new Timecode from formatted string, rate and ParseTimecodeOptionParam.
+// The format is HH Sep MM Sep SS LastSep FF, where Sep is one of "p:;.," and LastSep is one of ":;.,".
+// fixedRate reports whether DF or NDF of r is given explicitly.
+func parseTimecode(s string, r *rate, fixedRate bool, p ParseTimecodeOptionParam) (*Timecode, error) {
+	if p.Layout != "" {
+		return parseLayout(s, r, p)
+	}
+
+	sc := &scanner{input: s}
+	f := fields{negative: p.Signed && sc.sign()}
+
+	maxHours := 2
+	if p.Extended {
+		maxHours = 4
+	}
+	var err error
+	f.hhOffset = sc.pos
+	f.hh, err = sc.digits("hours", 2, maxHours)
+	if err != nil {
+		return nil, err
+	}
+	f.sep, err = sc.separator("separator", "p:;.,")
+	if err != nil {
+		if sc.pos < len(s) && isDigit(s[sc.pos]) {
+			return nil, sc.errorf("hours", sc.pos, "has too many digits")
+		}
+		return nil, err
+	}
+	f.mmOffset = sc.pos
+	f.mm, err = sc.digits("minutes", 2, 2)
+	if err != nil {
+		return nil, err
+	}
+	sepOffset := sc.pos
+	sep2, err := sc.separator("separator", "p:;.,")
+	if err != nil {
+		return nil, err
+	}
+	if sep2 != f.sep {
+		return nil, sc.errorf("separator", sepOffset, "is inconsistent with "+strconv.Quote(f.sep))
+	}
+	f.ssOffset = sc.pos
+	f.ss, err = sc.digits("seconds", 2, 2)
+	if err != nil {
+		return nil, err
+	}
+	f.lastSepOffset = sc.pos
+	f.lastSep, err = sc.separator("separator", ":;.,")
+	if err != nil {
+		return nil, err
+	}
+	f.ffOffset = sc.pos
+	f.ff, err = sc.digits("frames", 2, maxFramesWidth(r))
+	if err != nil {
+		return nil, err
+	}
+	if err := sc.end("frames"); err != nil {
+		return nil, err
+	}
+
+	return sc.newTimecode(f, r, fixedRate, p)
+}
+
+// fields represents parsed fields of timecode and their offsets.
+// Empty sep and lastSep are not typed, and they are formatted as ":".
+type fields struct {
+	negative                                              bool
+	hh, mm, ss, ff                                        uint64
+	hhOffset, mmOffset, ssOffset, ffOffset, lastSepOffset int
+	sep, lastSep                                          string
+}
+
+// newTimecode validates fields and returns new Timecode from them.
+func (sc *scanner) newTimecode(f fields, r *rate, fixedRate bool, p ParseTimecodeOptionParam) (*Timecode, error) {
+	if !p.Extended && f.hh >= 24 {
+		return nil, sc.errorf("hours", f.hhOffset, "is out of range")
+	}
+	if f.mm > 59 {
+		return nil, sc.errorf("minutes", f.mmOffset, "is out of range")
+	}
+	if f.ss > 59 {
+		return nil, sc.errorf("seconds", f.ssOffset, "is out of range")
+	}
+	if p.DetectDF && f.lastSep != "" {
+		detected, ok := detectRate(r, f.lastSep)
+		if ok && detected != r {
+			if fixedRate || !p.PreferDF && detected.dropFrames != 0 {
+				err := sc.errorf("separator", f.lastSepOffset, "contradicts frame rate "+r.frameRate().String())
+				err.Err = ErrMismatchFrameRate
+				return nil, err
+			}
+			r = detected
+			p.PreferDF = r.dropFrames != 0
+		}
+	}
+	if f.ff >= uint64(r.roundFPS) && (f.ff > 59 || p.Mode == ParseStrict) {
+		return nil, sc.errorf("frames", f.ffOffset, "is out of range")
+	}
+	if err := sc.checkDropped(f.hh, f.mm, f.ss, f.ff, f.ffOffset, r, p.Mode); err != nil {
+		return nil, err
+	}
+
+	if f.sep == "" {
+		f.sep = ":"
+	}
+	if f.lastSep == "" {
+		f.lastSep = ":"
+	}
+	return newParsedTimecode(f.negative, f.hh, f.mm, f.ss, f.ff, f.sep, f.lastSep, r, p), nil
+}
+
+// newFramesTimecode returns new Timecode from parsed number of frames.
+func newFramesTimecode(negative bool, frames uint64, r *rate, p ParseTimecodeOptionParam) (*Timecode, error) {
+	if frames > math.MaxInt64 {
+		return nil, ErrTooManyFrames
+	}
+	tc := &Timecode{
+		preferDF: p.PreferDF,
+		signed:   p.Signed,
+		extended: p.Extended,
+		sep:      ":",
+		lastSep:  ":",
+		r:        r,
+	}
+	if negative {
+		return resetSigned(tc, -int64(frames))
+	}
+	return Reset(tc, frames)
+}
@@ -0,0 +1,246 @@
+package timecode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseError(t *testing.T) {
+	for _, tt := range []struct {
+		s      string
+		field  string
+		offset int
+		reason string
+	}{
+		{"", "hours", 0, "is missing"},
+		{"0:01:00:00", "hours", 0, "has too few digits"},
+		{"001:00:00:00", "hours", 2, "has too many digits"},
+		{"24:00:00:00", "hours", 0, "is out of range"},
+		{"01", "separator", 2, "is missing"},
+		{"01?00:00:00", "separator", 2, "has invalid character '?'"},
+		{"01:00;00:00", "separator", 5, `is inconsistent with ":"`},
+		{"01:60:00:00", "minutes", 3, "is out of range"},
+		{"01:00:6x:00", "seconds", 7, "has invalid character 'x'"},
+		{"01:00:60:00", "seconds", 6, "is out of range"},
+		{"01:00:00p00", "separator", 8, "has invalid character 'p'"},
+		{"01:00:00:0x", "frames", 10, "has invalid character 'x'"},
+		{"01:00:00:", "frames", 9, "is missing"},
+		{"01:00:00:000", "frames", 11, "has too many digits"},
+		{"01:00:00:0000", "frames", 11, "has too many digits"},
+		{"01:00:00:00 ", "frames", 11, "is followed by unexpected ' '"},
+		{"01:00:00:60", "frames", 9, "is out of range"},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			tc, err := ParseTimecode(tt.s, 30, 1)
+			assert.Nil(t, tc)
+			assert.ErrorIs(t, err, ErrInvalidTimecode)
+
+			var perr *ParseError
+			if assert.True(t, errors.As(err, &perr)) {
+				assert.Equal(t, tt.s, perr.Input)
+				assert.Equal(t, tt.field, perr.Field)
+				assert.Equal(t, tt.offset, perr.Offset)
+				assert.Equal(t, tt.reason, perr.Reason)
+			}
+		})
+	}
+
+	t.Run("frames digits", func(t *testing.T) {
+		_, err := ParseTimecode("00:00:00:059", 25, 1)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+
+		tc, err := ParseTimecode("00:00:00:119", 120, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(119), tc.Frames())
+
+		_, err = ParseTimecodeFlexible("1:000", 25, 1)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+
+		tc, err = ParseTimecodeFlexible("1:119", 120, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(239), tc.Frames())
+	})
+	t.Run("signed offset", func(t *testing.T) {
+		_, err := ParseTimecode("-01:60:00:00", 30, 1, func(p *ParseTimecodeOptionParam) {
+			p.Signed = true
+		})
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr))
+		assert.Equal(t, 4, perr.Offset)
+	})
+	t.Run("layout", func(t *testing.T) {
+		_, err := ParseTimecode("01h6xm", 30, 1, func(p *ParseTimecodeOptionParam) {
+			p.Layout = "HHhMMm"
+		})
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr))
+		assert.Equal(t, "minutes", perr.Field)
+		assert.Equal(t, 4, perr.Offset)
+
+		_, err = ParseTimecode("01-00", 30, 1, func(p *ParseTimecodeOptionParam) {
+			p.Layout = "HHhMM"
+		})
+		assert.True(t, errors.As(err, &perr))
+		assert.Equal(t, "literal", perr.Field)
+		assert.Equal(t, 2, perr.Offset)
+	})
+	t.Run("Error", func(t *testing.T) {
+		_, err := ParseTimecode("01:60:00:00", 30, 1)
+		assert.EqualError(t, err, `parse timecode "01:60:00:00": minutes is out of range at offset 3`)
+	})
+}
+
+func TestParseMode(t *testing.T) {
+	strict := func(p *ParseTimecodeOptionParam) {
+		p.Mode = ParseStrict
+	}
+
+	t.Run("lenient", func(t *testing.T) {
+		tc, err := ParseTimecode("00:00:00:29", 25, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(29), tc.FF)
+
+		tc, err = ParseTimecode("00:01:00;00", 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00;02", tc.String())
+
+		tc, err = ParseTimecode("00:01:05;00", 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:05;00", tc.String())
+		assert.Equal(t, uint64(1948), tc.Frames())
+	})
+	t.Run("strict", func(t *testing.T) {
+		tc, err := ParseTimecode("00:00:00:24", 25, 1, strict)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(24), tc.Frames())
+
+		tc, err = ParseTimecode("00:10:00;00", 30000, 1001, strict)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(17982), tc.Frames())
+
+		tc, err = ParseTimecode("00:01:05;00", 30000, 1001, strict)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1948), tc.Frames())
+
+		tc, err = ParseTimecode("00:01:00:00", 30000, 1001, strict, func(p *ParseTimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1800), tc.Frames())
+	})
+	t.Run("strict/out of range", func(t *testing.T) {
+		_, err := ParseTimecode("00:00:00:25", 25, 1, strict)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+		assert.NotErrorIs(t, err, ErrDroppedFrame)
+
+		var perr *ParseError
+		assert.True(t, errors.As(err, &perr))
+		assert.Equal(t, "frames", perr.Field)
+		assert.Equal(t, 9, perr.Offset)
+	})
+	t.Run("strict/dropped", func(t *testing.T) {
+		for _, tt := range []struct {
+			s    string
+			num  int32
+			opts []ParseTimecodeOption
+		}{
+			{"00:01:00;00", 30000, nil},
+			{"00:01:00;01", 30000, nil},
+			{"23:59:00;01", 30000, nil},
+			{"00:09:00;03", 60000, nil},
+			{"00010001", 30000, []ParseTimecodeOption{func(p *ParseTimecodeOptionParam) { p.Layout = LayoutCompact }}},
+		} {
+			_, err := ParseTimecode(tt.s, tt.num, 1001, append(tt.opts, strict)...)
+			assert.ErrorIs(t, err, ErrDroppedFrame, tt.s)
+			assert.ErrorIs(t, err, ErrInvalidTimecode, tt.s)
+		}
+	})
+}
+
+func TestDetectDF(t *testing.T) {
+	detect := func(p *ParseTimecodeOptionParam) {
+		p.DetectDF = true
+	}
+
+	t.Run("ParseTimecode", func(t *testing.T) {
+		for _, tt := range []struct {
+			s      string
+			num    int32
+			den    int32
+			want   FrameRate
+			frames uint64
+		}{
+			{"00:01:00;02", 30000, 1001, Rate29_97DF, 1800},
+			{"00:01:00.02", 30000, 1001, Rate29_97DF, 1800},
+			{"00:01:00,02", 30000, 1001, Rate29_97DF, 1800},
+			{"00:01:00:02", 30000, 1001, Rate29_97NDF, 1802},
+			{"00:01:00;04", 60000, 1001, Rate59_94DF, 3600},
+			{"00:01:00:04", 60000, 1001, Rate59_94NDF, 3604},
+			{"00:01:00;00", 30, 1, Rate30, 1800},
+		} {
+			tc, err := ParseTimecode(tt.s, tt.num, tt.den, detect)
+			assert.NoError(t, err, tt.s)
+			assert.Equal(t, tt.want, tc.FrameRate(), tt.s)
+			assert.Equal(t, tt.frames, tc.Frames(), tt.s)
+			if tt.want.DropFrame {
+				assert.Equal(t, tt.s, tc.String(), tt.s)
+			}
+		}
+	})
+	t.Run("ParseTimecode/contradiction", func(t *testing.T) {
+		ndf := func(p *ParseTimecodeOptionParam) {
+			p.PreferDF = false
+		}
+		for _, s := range []string{"01:00:00;00", "01:00:00.00", "01:00:00,00"} {
+			tc, err := ParseTimecode(s, 30000, 1001, detect, ndf)
+			assert.Nil(t, tc, s)
+			assert.ErrorIs(t, err, ErrMismatchFrameRate, s)
+			var perr *ParseError
+			assert.ErrorAs(t, err, &perr, s)
+			assert.Equal(t, "separator", perr.Field, s)
+		}
+
+		tc, err := ParseTimecode("01:00:00:00", 30000, 1001, detect, ndf)
+		assert.NoError(t, err)
+		assert.Equal(t, Rate29_97NDF, tc.FrameRate())
+
+		tc, err = ParseTimecode("01:00:00;00", 30, 1, detect, ndf)
+		assert.NoError(t, err)
+		assert.Equal(t, Rate30, tc.FrameRate())
+	})
+	t.Run("ParseTimecodeWithRate", func(t *testing.T) {
+		tc, err := ParseTimecodeWithRate("00:01:00;02", Rate29_97DF, detect)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1800), tc.Frames())
+
+		tc, err = ParseTimecodeWithRate("00:01:00:02", Rate29_97NDF, detect)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1802), tc.Frames())
+	})
+	t.Run("ParseTimecodeWithRate/contradiction", func(t *testing.T) {
+		for _, tt := range []struct {
+			s  string
+			fr FrameRate
+		}{
+			{"00:01:00:02", Rate29_97DF},
+			{"00:01:00;02", Rate29_97NDF},
+			{"00:01:00.04", Rate59_94NDF},
+		} {
+			tc, err := ParseTimecodeWithRate(tt.s, tt.fr, detect)
+			assert.Nil(t, tc)
+			assert.ErrorIs(t, err, ErrMismatchFrameRate, tt.s)
+
+			var perr *ParseError
+			assert.True(t, errors.As(err, &perr))
+			assert.Equal(t, "separator", perr.Field)
+			assert.Equal(t, 8, perr.Offset)
+		}
+	})
+	t.Run("disabled", func(t *testing.T) {
+		tc, err := ParseTimecodeWithRate("00:01:00:02", Rate29_97DF)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1800), tc.Frames())
+	})
+}
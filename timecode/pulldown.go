@@ -0,0 +1,179 @@
+package timecode
+
+import "errors"
+
+var ErrInvalidCadence = errors.New("invalid cadence") // error for invalid cadence or phase
+
+// Cadence represents pulldown cadence, which spreads 4 film frames over 5 interlaced video frames.
+type Cadence int
+
+const (
+	Cadence23   Cadence = iota // 2:3 pulldown (AA BB BC CD DD)
+	Cadence2332                // 2:3:3:2 advanced pulldown (AA BB BC CC DD)
+	Cadence2224                // 2:2:2:4 pulldown (AA BB CC DD DD)
+)
+
+// cadenceFields represents number of fields of film frames A, B, C and D.
+var cadenceFields = [...][4]int64{
+	Cadence23:   {2, 3, 2, 3},
+	Cadence2332: {2, 3, 3, 2},
+	Cadence2224: {2, 2, 2, 4},
+}
+
+// cadenceFieldsPerCycle represents number of video fields per cadence cycle.
+const cadenceFieldsPerCycle = 10
+
+// Phase represents position of film frame in cadence.
+type Phase int
+
+const (
+	PhaseA Phase = iota // A frame
+	PhaseB              // B frame
+	PhaseC              // C frame
+	PhaseD              // D frame
+)
+
+// String returns Phase formatted string.
+func (ph Phase) String() string {
+	if ph < PhaseA || ph > PhaseD {
+		return "?"
+	}
+	return string(rune('A' + ph))
+}
+
+// Field represents field of interlaced video frame.
+type Field int
+
+const (
+	FirstField  Field = iota // first field in time
+	SecondField              // second field in time
+)
+
+// Pulldown maps film frames (e.g. 23.976) to interlaced video frames (e.g. 29.97) and back.
+type Pulldown struct {
+	cadence Cadence
+	film    *Timecode
+	video   *Timecode
+	phase   Phase
+	origin  int64 // video field where film frame A of the reference cycle starts
+}
+
+// PulldownFrame represents film frames carried by the fields of a video frame.
+type PulldownFrame struct {
+	First  *Timecode // film frame carried by the first field
+	Second *Timecode // film frame carried by the second field
+}
+
+// IsSplit returns whether the fields of the video frame carry different film frames.
+func (f PulldownFrame) IsSplit() bool {
+	return f.First.SignedFrames() != f.Second.SignedFrames()
+}
+
+// NewPulldown returns new Pulldown.
+// film is a film Timecode whose cadence phase is phase,
+// and video is the video Timecode of the frame in which the first field of film appears.
+// Video frame rate must be 5/4 of film frame rate.
+func NewPulldown(cadence Cadence, film *Timecode, phase Phase, video *Timecode) (*Pulldown, error) {
+	if film == nil || video == nil {
+		return nil, ErrNilTimecode
+	}
+	if cadence < Cadence23 || cadence > Cadence2224 || phase < PhaseA || phase > PhaseD {
+		return nil, ErrInvalidCadence
+	}
+	if int64(film.r.numerator)*5*int64(video.r.denominator) != int64(video.r.numerator)*4*int64(film.r.denominator) {
+		return nil, ErrMismatchFrameRate
+	}
+
+	start := cadenceStart(cadence, int64(phase))
+	return &Pulldown{
+		cadence: cadence,
+		film:    film,
+		video:   video,
+		phase:   phase,
+		origin:  2*video.SignedFrames() + start%2 - start,
+	}, nil
+}
+
+// cadenceStart returns video field offset of film frame j in cadence cycle.
+func cadenceStart(cadence Cadence, j int64) int64 {
+	var start int64
+	for i := int64(0); i < j; i++ {
+		start += cadenceFields[cadence][i]
+	}
+	return start
+}
+
+// floorDiv returns a/b rounded toward negative infinity and its non-negative remainder.
+func floorDiv(a, b int64) (int64, int64) {
+	q, m := a/b, a%b
+	if m < 0 {
+		q--
+		m += b
+	}
+	return q, m
+}
+
+// filmIndex returns film frame index relative to film frame A of the reference cycle.
+func (p *Pulldown) filmIndex(film *Timecode) (int64, error) {
+	if !p.film.r.equal(film.r) {
+		return 0, ErrMismatchFrameRate
+	}
+	return film.SignedFrames() - p.film.SignedFrames() + int64(p.phase), nil
+}
+
+// Phase returns cadence phase of film Timecode.
+func (p *Pulldown) Phase(film *Timecode) (Phase, error) {
+	k, err := p.filmIndex(film)
+	if err != nil {
+		return 0, err
+	}
+	_, j := floorDiv(k, 4)
+	return Phase(j), nil
+}
+
+// ToVideo returns video Timecode and its Field in which the first field of film appears.
+func (p *Pulldown) ToVideo(film *Timecode) (*Timecode, Field, error) {
+	k, err := p.filmIndex(film)
+	if err != nil {
+		return nil, 0, err
+	}
+	c, j := floorDiv(k, 4)
+	frames, field := floorDiv(p.origin+c*cadenceFieldsPerCycle+cadenceStart(p.cadence, j), 2)
+	video, err := resetSigned(p.video, frames)
+	if err != nil {
+		return nil, 0, err
+	}
+	return video, Field(field), nil
+}
+
+// ToFilm returns film Timecodes carried by the fields of video Timecode.
+func (p *Pulldown) ToFilm(video *Timecode) (PulldownFrame, error) {
+	if !p.video.r.equal(video.r) {
+		return PulldownFrame{}, ErrMismatchFrameRate
+	}
+
+	var films [2]*Timecode
+	for i := range films {
+		c, offset := floorDiv(2*video.SignedFrames()+int64(i)-p.origin, cadenceFieldsPerCycle)
+		j := int64(0)
+		for offset >= cadenceFields[p.cadence][j] {
+			offset -= cadenceFields[p.cadence][j]
+			j++
+		}
+		film, err := resetSigned(p.film, p.film.SignedFrames()-int64(p.phase)+c*4+j)
+		if err != nil {
+			return PulldownFrame{}, err
+		}
+		films[i] = film
+	}
+	return PulldownFrame{First: films[0], Second: films[1]}, nil
+}
+
+// IsSplitField returns whether the fields of video Timecode carry different film frames.
+func (p *Pulldown) IsSplitField(video *Timecode) (bool, error) {
+	f, err := p.ToFilm(video)
+	if err != nil {
+		return false, err
+	}
+	return f.IsSplit(), nil
+}
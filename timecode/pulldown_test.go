@@ -0,0 +1,173 @@
+package timecode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPulldown(t *testing.T) {
+	film, _ := ParseTimecode("01:00:00:00", 24000, 1001)
+	video, _ := ParseTimecode("01:00:00:00", 30000, 1001, func(p *ParseTimecodeOptionParam) {
+		p.PreferDF = false
+	})
+
+	type videoField struct {
+		video string
+		field Field
+	}
+	testCases := []struct {
+		name    string
+		cadence Cadence
+		toVideo []videoField // film frames 0..4
+		toFilm  [][2]uint64  // film frames (relative) of video frames 0..5
+		split   []bool
+	}{
+		{
+			name:    "2:3",
+			cadence: Cadence23,
+			toVideo: []videoField{
+				{"01:00:00:00", FirstField},
+				{"01:00:00:01", FirstField},
+				{"01:00:00:02", SecondField},
+				{"01:00:00:03", SecondField},
+				{"01:00:00:05", FirstField},
+			},
+			toFilm: [][2]uint64{{0, 0}, {1, 1}, {1, 2}, {2, 3}, {3, 3}, {4, 4}},
+			split:  []bool{false, false, true, true, false, false},
+		},
+		{
+			name:    "2:3:3:2",
+			cadence: Cadence2332,
+			toVideo: []videoField{
+				{"01:00:00:00", FirstField},
+				{"01:00:00:01", FirstField},
+				{"01:00:00:02", SecondField},
+				{"01:00:00:04", FirstField},
+				{"01:00:00:05", FirstField},
+			},
+			toFilm: [][2]uint64{{0, 0}, {1, 1}, {1, 2}, {2, 2}, {3, 3}, {4, 4}},
+			split:  []bool{false, false, true, false, false, false},
+		},
+		{
+			name:    "2:2:2:4",
+			cadence: Cadence2224,
+			toVideo: []videoField{
+				{"01:00:00:00", FirstField},
+				{"01:00:00:01", FirstField},
+				{"01:00:00:02", FirstField},
+				{"01:00:00:03", FirstField},
+				{"01:00:00:05", FirstField},
+			},
+			toFilm: [][2]uint64{{0, 0}, {1, 1}, {2, 2}, {3, 3}, {3, 3}, {4, 4}},
+			split:  []bool{false, false, false, false, false, false},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewPulldown(tc.cadence, film, PhaseA, video)
+			assert.NoError(t, err)
+
+			for i, want := range tc.toVideo {
+				f, _ := film.AddFrames(uint64(i))
+				v, field, err := p.ToVideo(f)
+				assert.NoError(t, err)
+				assert.Equal(t, want.video, v.String())
+				assert.Equal(t, want.field, field)
+
+				phase, err := p.Phase(f)
+				assert.NoError(t, err)
+				assert.Equal(t, Phase(i%4), phase)
+			}
+			for i, want := range tc.toFilm {
+				v, _ := video.AddFrames(uint64(i))
+				f, err := p.ToFilm(v)
+				assert.NoError(t, err)
+				assert.Equal(t, film.Frames()+want[0], f.First.Frames())
+				assert.Equal(t, film.Frames()+want[1], f.Second.Frames())
+				assert.Equal(t, tc.split[i], f.IsSplit())
+
+				split, err := p.IsSplitField(v)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.split[i], split)
+			}
+		})
+	}
+
+	t.Run("2:3/phase C", func(t *testing.T) {
+		p, err := NewPulldown(Cadence23, film, PhaseC, video)
+		assert.NoError(t, err)
+
+		v, field, err := p.ToVideo(film)
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", v.String())
+		assert.Equal(t, SecondField, field)
+
+		f, err := p.ToFilm(video)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:59:59:23", f.First.String()) // B frame
+		assert.Equal(t, "01:00:00:00", f.Second.String())
+		assert.True(t, f.IsSplit())
+
+		prev, _ := film.SubFrames(2)
+		phase, err := p.Phase(prev)
+		assert.NoError(t, err)
+		assert.Equal(t, PhaseA, phase)
+		v, field, err = p.ToVideo(prev)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:59:59:28", v.String())
+		assert.Equal(t, FirstField, field)
+	})
+	t.Run("24 to 30", func(t *testing.T) {
+		film24, _ := NewTimecode(0, 24, 1)
+		video30, _ := NewTimecode(0, 30, 1)
+		p, err := NewPulldown(Cadence23, film24, PhaseA, video30)
+		assert.NoError(t, err)
+
+		f, _ := film24.AddFrames(24)
+		v, field, err := p.ToVideo(f)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:01:00", v.String())
+		assert.Equal(t, FirstField, field)
+	})
+	t.Run("error/underflow", func(t *testing.T) {
+		videoZero, _ := Reset(video, 0)
+		p, _ := NewPulldown(Cadence23, film, PhaseA, videoZero)
+		prev, _ := film.SubFrames(1)
+		v, _, err := p.ToVideo(prev)
+		assert.Nil(t, v)
+		assert.Equal(t, ErrUnderflowFrames, err)
+	})
+	t.Run("error/mismatch frame rate", func(t *testing.T) {
+		tc25, _ := NewTimecode(0, 25, 1)
+		p, err := NewPulldown(Cadence23, film, PhaseA, tc25)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+
+		p, _ = NewPulldown(Cadence23, film, PhaseA, video)
+		_, _, err = p.ToVideo(video)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+		_, err = p.ToFilm(film)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+	})
+	t.Run("error/invalid cadence", func(t *testing.T) {
+		p, err := NewPulldown(Cadence(3), film, PhaseA, video)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrInvalidCadence, err)
+
+		p, err = NewPulldown(Cadence23, film, Phase(4), video)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrInvalidCadence, err)
+	})
+	t.Run("error/nil", func(t *testing.T) {
+		p, err := NewPulldown(Cadence23, nil, PhaseA, video)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrNilTimecode, err)
+	})
+}
+
+func TestPhaseString(t *testing.T) {
+	assert.Equal(t, "A", PhaseA.String())
+	assert.Equal(t, "D", PhaseD.String())
+	assert.Equal(t, "?", Phase(4).String())
+}
@@ -0,0 +1,201 @@
+package timecode
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidRange = errors.New("invalid range") // error for out point before in point or split point outside range
+	ErrNoOverlap    = errors.New("no overlap")    // error for disjoint ranges
+)
+
+// Range represents interval of frames between in and out Timecodes, e.g. EDL event or ad break.
+type Range struct {
+	in        *Timecode
+	out       *Timecode
+	inclusive bool
+}
+
+// RangeOptionParam represents Range option parameter.
+type RangeOptionParam struct {
+	InclusiveOut bool // out point is the last frame of range, otherwise the frame after range
+}
+
+// RangeOption represents Range option.
+type RangeOption func(*RangeOptionParam)
+
+// newRangeOptionParam returns new RangeOptionParam.
+func newRangeOptionParam() RangeOptionParam {
+	return RangeOptionParam{
+		InclusiveOut: false, // EDL convention
+	}
+}
+
+// applyRangeOption applies RangeOption to RangeOptionParam.
+func (p *RangeOptionParam) applyRangeOption(opts ...RangeOption) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
+
+// NewRange returns new Range from in and out Timecodes.
+func NewRange(in, out *Timecode, opts ...RangeOption) (*Range, error) {
+	p := newRangeOptionParam()
+	p.applyRangeOption(opts...)
+
+	if in == nil || out == nil {
+		return nil, ErrNilTimecode
+	}
+	if !in.r.equal(out.r) {
+		return nil, ErrMismatchFrameRate
+	}
+	r := &Range{in: in, out: out, inclusive: p.InclusiveOut}
+	if r.end() < r.start() {
+		return nil, ErrInvalidRange
+	}
+	return r, nil
+}
+
+// newRangeFrames returns new Range of frames [start, end) in the same mode as r.
+func (r *Range) newRangeFrames(start, end int64) (*Range, error) {
+	in, err := resetSigned(r.in, start)
+	if err != nil {
+		return nil, err
+	}
+	if r.inclusive {
+		end--
+	}
+	out, err := resetSigned(r.out, end)
+	if err != nil {
+		return nil, err
+	}
+	return &Range{in: in, out: out, inclusive: r.inclusive}, nil
+}
+
+// start returns the first frame of range.
+func (r *Range) start() int64 {
+	return r.in.SignedFrames()
+}
+
+// end returns the frame after range.
+func (r *Range) end() int64 {
+	if r.inclusive {
+		return r.out.SignedFrames() + 1
+	}
+	return r.out.SignedFrames()
+}
+
+// In returns in point.
+func (r *Range) In() *Timecode {
+	return r.in
+}
+
+// Out returns out point.
+func (r *Range) Out() *Timecode {
+	return r.out
+}
+
+// InclusiveOut returns whether out point is the last frame of range.
+func (r *Range) InclusiveOut() bool {
+	return r.inclusive
+}
+
+// Frames returns number of frames of range.
+func (r *Range) Frames() uint64 {
+	return uint64(r.end() - r.start())
+}
+
+// Duration returns real duration of range.
+func (r *Range) Duration() time.Duration {
+	return r.in.r.duration(r.Frames())
+}
+
+// Contains returns whether range contains Timecode.
+func (r *Range) Contains(tc *Timecode) (bool, error) {
+	if !r.in.r.equal(tc.r) {
+		return false, ErrMismatchFrameRate
+	}
+	f := tc.SignedFrames()
+	return r.start() <= f && f < r.end(), nil
+}
+
+// Overlaps returns whether range and other range share at least one frame.
+func (r *Range) Overlaps(other *Range) (bool, error) {
+	if !r.in.r.equal(other.in.r) {
+		return false, ErrMismatchFrameRate
+	}
+	return r.start() < other.end() && other.start() < r.end(), nil
+}
+
+// Intersect returns intersection of range and other range.
+func (r *Range) Intersect(other *Range) (*Range, error) {
+	overlaps, err := r.Overlaps(other)
+	if err != nil {
+		return nil, err
+	}
+	if !overlaps {
+		return nil, ErrNoOverlap
+	}
+	start, end := r.start(), r.end()
+	if other.start() > start {
+		start = other.start()
+	}
+	if other.end() < end {
+		end = other.end()
+	}
+	return r.newRangeFrames(start, end)
+}
+
+// Union returns union of range and other range, which must overlap or be adjacent.
+func (r *Range) Union(other *Range) (*Range, error) {
+	if !r.in.r.equal(other.in.r) {
+		return nil, ErrMismatchFrameRate
+	}
+	if r.start() > other.end() || other.start() > r.end() {
+		return nil, ErrNoOverlap
+	}
+	start, end := r.start(), r.end()
+	if other.start() < start {
+		start = other.start()
+	}
+	if other.end() > end {
+		end = other.end()
+	}
+	return r.newRangeFrames(start, end)
+}
+
+// SplitAt splits range into the frames before Timecode and the frames from Timecode.
+// Timecode must be inside range and not be in point.
+func (r *Range) SplitAt(tc *Timecode) (*Range, *Range, error) {
+	if !r.in.r.equal(tc.r) {
+		return nil, nil, ErrMismatchFrameRate
+	}
+	f := tc.SignedFrames()
+	if f <= r.start() || f >= r.end() {
+		return nil, nil, ErrInvalidRange
+	}
+	first, err := r.newRangeFrames(r.start(), f)
+	if err != nil {
+		return nil, nil, err
+	}
+	second, err := r.newRangeFrames(f, r.end())
+	if err != nil {
+		return nil, nil, err
+	}
+	return first, second, nil
+}
+
+// Offset returns new Range shifted by signed frames.
+func (r *Range) Offset(frames int64) (*Range, error) {
+	return r.newRangeFrames(r.start()+frames, r.end()+frames)
+}
+
+// String returns Range formatted string.
+// e.g. [01:00:00:00, 01:00:10:00), [01:00:00:00, 01:00:09:29]
+func (r *Range) String() string {
+	if r.inclusive {
+		return "[" + r.in.String() + ", " + r.out.String() + "]"
+	}
+	return "[" + r.in.String() + ", " + r.out.String() + ")"
+}
@@ -0,0 +1,184 @@
+package timecode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	parse := func(s string) *Timecode {
+		tc, err := ParseTimecode(s, 25, 1)
+		if err != nil {
+			panic(err)
+		}
+		return tc
+	}
+	newRange := func(in, out string, opts ...RangeOption) *Range {
+		r, err := NewRange(parse(in), parse(out), opts...)
+		if err != nil {
+			panic(err)
+		}
+		return r
+	}
+	inclusive := func(p *RangeOptionParam) {
+		p.InclusiveOut = true
+	}
+
+	t.Run("NewRange", func(t *testing.T) {
+		r := newRange("01:00:00:00", "01:00:10:00")
+		assert.Equal(t, "01:00:00:00", r.In().String())
+		assert.Equal(t, "01:00:10:00", r.Out().String())
+		assert.False(t, r.InclusiveOut())
+		assert.Equal(t, uint64(250), r.Frames())
+		assert.Equal(t, 10*time.Second, r.Duration())
+		assert.Equal(t, "[01:00:00:00, 01:00:10:00)", r.String())
+
+		r = newRange("01:00:00:00", "01:00:09:24", inclusive)
+		assert.True(t, r.InclusiveOut())
+		assert.Equal(t, uint64(250), r.Frames())
+		assert.Equal(t, "[01:00:00:00, 01:00:09:24]", r.String())
+
+		r = newRange("01:00:00:00", "01:00:00:00")
+		assert.Equal(t, uint64(0), r.Frames())
+	})
+	t.Run("Contains", func(t *testing.T) {
+		r := newRange("01:00:00:00", "01:00:10:00")
+		for s, want := range map[string]bool{
+			"00:59:59:24": false,
+			"01:00:00:00": true,
+			"01:00:09:24": true,
+			"01:00:10:00": false,
+		} {
+			ok, err := r.Contains(parse(s))
+			assert.NoError(t, err)
+			assert.Equal(t, want, ok, s)
+		}
+
+		r = newRange("01:00:00:00", "01:00:10:00", inclusive)
+		ok, err := r.Contains(parse("01:00:10:00"))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("Overlaps", func(t *testing.T) {
+		r := newRange("01:00:00:00", "01:00:10:00")
+		ok, err := r.Overlaps(newRange("01:00:09:24", "01:00:20:00"))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = r.Overlaps(newRange("01:00:10:00", "01:00:20:00"))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = r.Overlaps(newRange("01:00:10:00", "01:00:20:00", inclusive))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = r.Overlaps(newRange("00:59:00:00", "01:00:00:00", inclusive))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("Intersect", func(t *testing.T) {
+		r := newRange("01:00:00:00", "01:00:10:00")
+		i, err := r.Intersect(newRange("01:00:05:00", "01:00:20:00"))
+		assert.NoError(t, err)
+		assert.Equal(t, "[01:00:05:00, 01:00:10:00)", i.String())
+
+		r = newRange("01:00:00:00", "01:00:09:24", inclusive)
+		i, err = r.Intersect(newRange("01:00:05:00", "01:00:20:00"))
+		assert.NoError(t, err)
+		assert.Equal(t, "[01:00:05:00, 01:00:09:24]", i.String())
+
+		i, err = r.Intersect(newRange("01:00:10:00", "01:00:20:00"))
+		assert.Nil(t, i)
+		assert.Equal(t, ErrNoOverlap, err)
+	})
+	t.Run("Union", func(t *testing.T) {
+		r := newRange("01:00:00:00", "01:00:10:00")
+		u, err := r.Union(newRange("01:00:05:00", "01:00:20:00"))
+		assert.NoError(t, err)
+		assert.Equal(t, "[01:00:00:00, 01:00:20:00)", u.String())
+
+		u, err = r.Union(newRange("01:00:10:00", "01:00:20:00"))
+		assert.NoError(t, err)
+		assert.Equal(t, "[01:00:00:00, 01:00:20:00)", u.String())
+
+		u, err = r.Union(newRange("01:00:10:01", "01:00:20:00"))
+		assert.Nil(t, u)
+		assert.Equal(t, ErrNoOverlap, err)
+	})
+	t.Run("SplitAt", func(t *testing.T) {
+		r := newRange("01:00:00:00", "01:00:10:00")
+		first, second, err := r.SplitAt(parse("01:00:04:00"))
+		assert.NoError(t, err)
+		assert.Equal(t, "[01:00:00:00, 01:00:04:00)", first.String())
+		assert.Equal(t, "[01:00:04:00, 01:00:10:00)", second.String())
+
+		r = newRange("01:00:00:00", "01:00:09:24", inclusive)
+		first, second, err = r.SplitAt(parse("01:00:04:00"))
+		assert.NoError(t, err)
+		assert.Equal(t, "[01:00:00:00, 01:00:03:24]", first.String())
+		assert.Equal(t, "[01:00:04:00, 01:00:09:24]", second.String())
+
+		_, _, err = r.SplitAt(parse("01:00:00:00"))
+		assert.Equal(t, ErrInvalidRange, err)
+		_, _, err = r.SplitAt(parse("01:00:10:00"))
+		assert.Equal(t, ErrInvalidRange, err)
+	})
+	t.Run("Offset", func(t *testing.T) {
+		r := newRange("01:00:00:00", "01:00:10:00")
+		o, err := r.Offset(25)
+		assert.NoError(t, err)
+		assert.Equal(t, "[01:00:01:00, 01:00:11:00)", o.String())
+
+		o, err = r.Offset(-25 * 3600)
+		assert.NoError(t, err)
+		assert.Equal(t, "[00:00:00:00, 00:00:10:00)", o.String())
+
+		o, err = r.Offset(-25*3600 - 1)
+		assert.Nil(t, o)
+		assert.Equal(t, ErrUnderflowFrames, err)
+	})
+	t.Run("DF", func(t *testing.T) {
+		in, _ := ParseTimecode("00:00:59;00", 30000, 1001)
+		out, _ := ParseTimecode("00:01:00;02", 30000, 1001)
+		r, err := NewRange(in, out)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(30), r.Frames())
+	})
+	t.Run("error/invalid range", func(t *testing.T) {
+		r, err := NewRange(parse("01:00:00:00"), parse("00:59:59:24"))
+		assert.Nil(t, r)
+		assert.Equal(t, ErrInvalidRange, err)
+
+		r, err = NewRange(parse("01:00:00:00"), parse("00:59:59:24"), inclusive)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), r.Frames())
+
+		r, err = NewRange(parse("01:00:00:00"), parse("00:59:59:23"), inclusive)
+		assert.Nil(t, r)
+		assert.Equal(t, ErrInvalidRange, err)
+	})
+	t.Run("error/mismatch frame rate", func(t *testing.T) {
+		tc24, _ := ParseTimecode("01:00:00:00", 24, 1)
+		r, err := NewRange(parse("00:00:00:00"), tc24)
+		assert.Nil(t, r)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+
+		r = newRange("00:00:00:00", "01:00:00:00")
+		_, err = r.Contains(tc24)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+		r24, _ := NewRange(tc24, tc24)
+		_, err = r.Overlaps(r24)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+		_, err = r.Intersect(r24)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+		_, err = r.Union(r24)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+		_, _, err = r.SplitAt(tc24)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+	})
+	t.Run("error/nil", func(t *testing.T) {
+		r, err := NewRange(nil, parse("00:00:00:00"))
+		assert.Nil(t, r)
+		assert.Equal(t, ErrNilTimecode, err)
+	})
+}
@@ -0,0 +1,139 @@
+package timecode
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Value implements driver.Valuer.
+// Timecode is stored as string of MarshalText, e.g. 01:00:00;00@30000/1001.
+func (tc Timecode) Value() (driver.Value, error) {
+	if tc.IsZero() {
+		return nil, ErrNilTimecode
+	}
+	text, err := tc.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts string of MarshalText and frames-plus-rate encoding of TimecodeFrames.
+func (tc *Timecode) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		return ErrNilTimecode
+	default:
+		return fmt.Errorf("cannot scan %T into Timecode: %w", src, ErrInvalidTimecode)
+	}
+
+	i := strings.LastIndexByte(s, '@')
+	if i < 0 {
+		if s == "" {
+			return fmt.Errorf("cannot scan empty string into Timecode: %w", ErrInvalidTimecode)
+		}
+		return tc.UnmarshalText([]byte(s))
+	}
+	frames, err := strconv.ParseInt(s[:i], 10, 64)
+	if err != nil {
+		return tc.UnmarshalText([]byte(s))
+	}
+	fr, err := ParseFrameRate(s[i+1:])
+	if err != nil {
+		return err
+	}
+	parsed, err := newSignedTimecode(frames, fr)
+	if err != nil {
+		return err
+	}
+	*tc = *parsed
+	return nil
+}
+
+// newSignedTimecode returns new Timecode from signed frames and FrameRate.
+// Signed is set for negative frames, and Extended for 24 hours and more.
+func newSignedTimecode(frames int64, fr FrameRate) (*Timecode, error) {
+	r, err := fr.rate()
+	if err != nil {
+		return nil, err
+	}
+	abs := uint64(frames)
+	if frames < 0 {
+		abs = uint64(-frames)
+	}
+	p := newTimecodeOptionParam()
+	p.PreferDF = fr.DropFrame
+	p.Signed = frames < 0
+	p.Extended = abs >= r.framesPerDay()
+	tc, err := newTimecode(0, r, p)
+	if err != nil {
+		return nil, err
+	}
+	return resetSigned(tc, frames)
+}
+
+// TimecodeFrames represents Timecode stored in frames-plus-rate encoding, e.g. 107892@30000/1001DF.
+// Frame rate has "DF" suffix if DF, and "NDF" suffix if NDF and the frame rate also supports DF.
+type TimecodeFrames struct {
+	Timecode
+}
+
+// Value implements driver.Valuer.
+func (tf TimecodeFrames) Value() (driver.Value, error) {
+	if tf.IsZero() {
+		return nil, ErrNilTimecode
+	}
+	b := strconv.AppendInt(make([]byte, 0, 32), tf.SignedFrames(), 10)
+	b = append(b, '@')
+	b = strconv.AppendInt(b, int64(tf.r.numerator), 10)
+	b = append(b, '/')
+	b = strconv.AppendInt(b, int64(tf.r.denominator), 10)
+	if tf.r.dropFrames != 0 {
+		b = append(b, "DF"...)
+	} else if _, err := newDFRate(tf.r.numerator, tf.r.denominator); err == nil {
+		b = append(b, "NDF"...)
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.
+func (tf *TimecodeFrames) Scan(src any) error {
+	return tf.Timecode.Scan(src)
+}
+
+// NullTimecode represents Timecode that may be null.
+// It implements sql.Scanner and driver.Valuer like sql.NullTime.
+type NullTimecode struct {
+	Timecode Timecode
+	Valid    bool // Valid is true if Timecode is not NULL
+}
+
+// Value implements driver.Valuer.
+func (n NullTimecode) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Timecode.Value()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullTimecode) Scan(src any) error {
+	if src == nil {
+		n.Timecode, n.Valid = Timecode{}, false
+		return nil
+	}
+	if err := n.Timecode.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
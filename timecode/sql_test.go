@@ -0,0 +1,108 @@
+package timecode
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ driver.Valuer = Timecode{}
+	_ sql.Scanner   = (*Timecode)(nil)
+	_ driver.Valuer = TimecodeFrames{}
+	_ sql.Scanner   = (*TimecodeFrames)(nil)
+	_ driver.Valuer = NullTimecode{}
+	_ sql.Scanner   = (*NullTimecode)(nil)
+)
+
+func TestSQL(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00;00", 30000, 1001)
+		v, err := tc.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00;00@30000/1001", v)
+
+		_, err = Timecode{}.Value()
+		assert.Equal(t, ErrNilTimecode, err)
+	})
+	t.Run("Scan", func(t *testing.T) {
+		for _, tt := range []struct {
+			src    any
+			want   FrameRate
+			frames int64
+		}{
+			{"01:00:00;00@30000/1001", Rate29_97DF, 107892},
+			{[]byte("01:00:00:00@30000/1001"), Rate29_97NDF, 108000},
+			{"107892@30000/1001DF", Rate29_97DF, 107892},
+			{"108000@30000/1001NDF", Rate29_97NDF, 108000},
+			{"90000@25/1", Rate25, 90000},
+			{"-25@25/1", Rate25, -25},
+			{"2160000@25/1", Rate25, 2160000},
+		} {
+			var tc Timecode
+			assert.NoError(t, tc.Scan(tt.src), tt.src)
+			assert.Equal(t, tt.want, tc.FrameRate(), tt.src)
+			assert.Equal(t, tt.frames, tc.SignedFrames(), tt.src)
+		}
+	})
+	t.Run("Scan/error", func(t *testing.T) {
+		var tc Timecode
+		assert.Equal(t, ErrNilTimecode, tc.Scan(nil))
+		assert.ErrorIs(t, tc.Scan(123), ErrInvalidTimecode)
+		assert.ErrorIs(t, tc.Scan("01:00:00:00"), ErrInvalidTimecode)
+		assert.ErrorIs(t, tc.Scan(""), ErrInvalidTimecode)
+		assert.ErrorIs(t, tc.Scan("12x@25/1"), ErrInvalidTimecode)
+		assert.Equal(t, ErrUnsupportedFrameRate, tc.Scan("100@1/1"))
+		assert.Equal(t, ErrTooManyFrames, tc.Scan("900000000@25/1"))
+	})
+	t.Run("TimecodeFrames", func(t *testing.T) {
+		for _, tt := range []struct {
+			s    string
+			num  int32
+			den  int32
+			want string
+		}{
+			{"01:00:00;00", 30000, 1001, "107892@30000/1001DF"},
+			{"01:00:00:00", 25, 1, "90000@25/1"},
+		} {
+			tc, _ := ParseTimecode(tt.s, tt.num, tt.den)
+			v, err := TimecodeFrames{*tc}.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, v)
+
+			var tf TimecodeFrames
+			assert.NoError(t, tf.Scan(v))
+			assert.Equal(t, tc.Frames(), tf.Frames())
+			assert.Equal(t, tc.FrameRate(), tf.FrameRate())
+		}
+
+		tc, _ := ParseTimecode("01:00:00:00", 30000, 1001, func(p *ParseTimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		v, _ := TimecodeFrames{*tc}.Value()
+		assert.Equal(t, "108000@30000/1001NDF", v)
+
+		_, err := TimecodeFrames{}.Value()
+		assert.Equal(t, ErrNilTimecode, err)
+	})
+	t.Run("NullTimecode", func(t *testing.T) {
+		var n NullTimecode
+		assert.NoError(t, n.Scan("00:00:01:00@25/1"))
+		assert.True(t, n.Valid)
+		assert.Equal(t, uint64(25), n.Timecode.Frames())
+		v, err := n.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:01:00@25/1", v)
+
+		assert.NoError(t, n.Scan(nil))
+		assert.False(t, n.Valid)
+		v, err = n.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, v)
+
+		assert.Error(t, n.Scan("xx"))
+		assert.False(t, n.Valid)
+	})
+}
@@ -3,48 +3,85 @@ package timecode
 import (
 	"errors"
 	"fmt"
-	"regexp"
-	"strconv"
+	"math"
+	"math/bits"
+	"sync"
 	"time"
 )
 
 // rate represents frame rate.
 type rate struct {
 	roundFPS       int
-	actualFPS      float64
 	numerator      int32
 	denominator    int32
 	dropFrames     int
+	cycleMinutes   int
 	framesPer1Min  int
-	framesPer10Min int
+	framesPerCycle int
+	id             uint16 // index of registeredRates plus one
 }
 
+// maxExtendedHours represents upper limit of hours in extended mode.
+const maxExtendedHours = 10000
+
 var (
-	// supportedNDFRates represents supported frame rates 23.976, 24, 25, 29.97NDF, 30, 48, 50, 59.94NDF, 60.
+	// supportedNDFRates represents supported frame rates 10, 15, 23.976, 24, 25, 29.97NDF, 30, 48, 50, 59.94NDF, 60, 100, 119.88NDF, 120.
 	supportedNDFRates = []*rate{
-		{roundFPS: 10, actualFPS: 10, numerator: 10, denominator: 1, dropFrames: 0, framesPer1Min: 10 * 60, framesPer10Min: 10 * 600},           // 10
-		{roundFPS: 15, actualFPS: 15, numerator: 15, denominator: 1, dropFrames: 0, framesPer1Min: 15 * 60, framesPer10Min: 15 * 600},           // 15
-		{roundFPS: 24, actualFPS: 23.976, numerator: 24000, denominator: 1001, dropFrames: 0, framesPer1Min: 24 * 60, framesPer10Min: 24 * 600}, // 23.976
-		{roundFPS: 24, actualFPS: 24, numerator: 24, denominator: 1, dropFrames: 0, framesPer1Min: 24 * 60, framesPer10Min: 24 * 600},           // 24
-		{roundFPS: 25, actualFPS: 25, numerator: 25, denominator: 1, dropFrames: 0, framesPer1Min: 25 * 60, framesPer10Min: 25 * 600},           // 25
-		{roundFPS: 30, actualFPS: 29.97, numerator: 30000, denominator: 1001, dropFrames: 0, framesPer1Min: 30 * 60, framesPer10Min: 30 * 600},  // 29.97NDF (optional)
-		{roundFPS: 30, actualFPS: 30, numerator: 30, denominator: 1, dropFrames: 0, framesPer1Min: 30 * 60, framesPer10Min: 30 * 600},           // 30
-		{roundFPS: 48, actualFPS: 48, numerator: 48, denominator: 1, dropFrames: 0, framesPer1Min: 48 * 60, framesPer10Min: 48 * 600},           // 48
-		{roundFPS: 50, actualFPS: 50, numerator: 50, denominator: 1, dropFrames: 0, framesPer1Min: 50 * 60, framesPer10Min: 50 * 600},           // 50
-		{roundFPS: 60, actualFPS: 59.94, numerator: 60000, denominator: 1001, dropFrames: 0, framesPer1Min: 60 * 60, framesPer10Min: 60 * 600},  // 59.94NDF (optional)
-		{roundFPS: 60, actualFPS: 60, numerator: 60, denominator: 1, dropFrames: 0, framesPer1Min: 60 * 60, framesPer10Min: 60 * 600},           // 60
-	}
-
-	// supportedDFRates represents supported frame rates 29.97DF, 59.94DF.
+		{roundFPS: 10, numerator: 10, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 10 * 60, framesPerCycle: 10 * 600},           // 10
+		{roundFPS: 15, numerator: 15, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 15 * 60, framesPerCycle: 15 * 600},           // 15
+		{roundFPS: 24, numerator: 24000, denominator: 1001, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 24 * 60, framesPerCycle: 24 * 600},     // 23.976
+		{roundFPS: 24, numerator: 24, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 24 * 60, framesPerCycle: 24 * 600},           // 24
+		{roundFPS: 25, numerator: 25, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 25 * 60, framesPerCycle: 25 * 600},           // 25
+		{roundFPS: 30, numerator: 30000, denominator: 1001, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 30 * 60, framesPerCycle: 30 * 600},     // 29.97NDF (optional)
+		{roundFPS: 30, numerator: 30, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 30 * 60, framesPerCycle: 30 * 600},           // 30
+		{roundFPS: 48, numerator: 48, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 48 * 60, framesPerCycle: 48 * 600},           // 48
+		{roundFPS: 50, numerator: 50, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 50 * 60, framesPerCycle: 50 * 600},           // 50
+		{roundFPS: 60, numerator: 60000, denominator: 1001, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 60 * 60, framesPerCycle: 60 * 600},     // 59.94NDF (optional)
+		{roundFPS: 60, numerator: 60, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 60 * 60, framesPerCycle: 60 * 600},           // 60
+		{roundFPS: 100, numerator: 100, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 100 * 60, framesPerCycle: 100 * 600},       // 100
+		{roundFPS: 120, numerator: 120000, denominator: 1001, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 120 * 60, framesPerCycle: 120 * 600}, // 119.88NDF (optional)
+		{roundFPS: 120, numerator: 120, denominator: 1, dropFrames: 0, cycleMinutes: 10, framesPer1Min: 120 * 60, framesPerCycle: 120 * 600},       // 120
+	}
+
+	// supportedDFRates represents supported frame rates 29.97DF, 59.94DF, 119.88DF.
 	supportedDFRates = []*rate{
-		{roundFPS: 30, actualFPS: 29.97, numerator: 30000, denominator: 1001, dropFrames: 2, framesPer1Min: 30*60 - 2, framesPer10Min: 30*600 - 9*2}, // 29.97DF (preferred)
-		{roundFPS: 60, actualFPS: 59.94, numerator: 60000, denominator: 1001, dropFrames: 4, framesPer1Min: 60*60 - 4, framesPer10Min: 60*600 - 9*4}, // 59.94DF (preferred)
+		{roundFPS: 30, numerator: 30000, denominator: 1001, dropFrames: 2, cycleMinutes: 10, framesPer1Min: 30*60 - 2, framesPerCycle: 30*600 - 9*2},     // 29.97DF (preferred)
+		{roundFPS: 60, numerator: 60000, denominator: 1001, dropFrames: 4, cycleMinutes: 10, framesPer1Min: 60*60 - 4, framesPerCycle: 60*600 - 9*4},     // 59.94DF (preferred)
+		{roundFPS: 120, numerator: 120000, denominator: 1001, dropFrames: 8, cycleMinutes: 10, framesPer1Min: 120*60 - 8, framesPerCycle: 120*600 - 9*8}, // 119.88DF (preferred)
 	}
 
-	// timecodePattern represents timecode pattern.
-	timecodePattern = regexp.MustCompile(`^([01][0-9]|2[0-3])([p:;.,])([0-5][0-9])([p:;.,])([0-5][0-9])([:;.,])([0-5][0-9])$`)
+	// registeredRates represents all supported frame rates in order of registration.
+	registeredRates []*rate
+
+	// ratesMu guards supportedNDFRates, supportedDFRates and registeredRates.
+	ratesMu sync.RWMutex
 )
 
+func init() {
+	for _, rates := range [][]*rate{supportedNDFRates, supportedDFRates} {
+		for _, r := range rates {
+			registerRate(r)
+		}
+	}
+}
+
+// registerRate appends rate to registeredRates and assigns its id.
+// ratesMu must be locked by caller.
+func registerRate(r *rate) {
+	registeredRates = append(registeredRates, r)
+	r.id = uint16(len(registeredRates))
+}
+
+// rateByID returns registered rate of id.
+func rateByID(id uint16) (*rate, error) {
+	if id == 0 {
+		return nil, ErrUnsupportedFrameRate
+	}
+	ratesMu.RLock()
+	defer ratesMu.RUnlock()
+	return registeredRates[id-1], nil
+}
+
 var (
 	ErrNilTimecode          = errors.New("nil timecode")           // error for nil timecode
 	ErrUnsupportedFrameRate = errors.New("unsupported frame rate") // error for unsupported frame rate
@@ -52,40 +89,91 @@ var (
 	ErrUnderflowFrames      = errors.New("underflow frames")       // error for underflow frames
 	ErrInvalidTimecode      = errors.New("invalid timecode")       // error for invalid timecode
 	ErrTooManyFrames        = errors.New("too many frames")        // error for too many frames
+	ErrInvalidFrameRate     = errors.New("invalid frame rate")     // error for invalid frame rate
+	ErrDuplicateFrameRate   = errors.New("duplicate frame rate")   // error for duplicate frame rate
+	ErrDroppedFrame         = errors.New("dropped frame")          // error for label dropped by drop-frame
+	ErrInvalidLayout        = errors.New("invalid layout")         // error for invalid layout
 )
 
 // Timecode represents timecode.
 type Timecode struct {
 	preferDF bool
+	signed   bool
+	extended bool
 	sep      string
 	lastSep  string
 	r        *rate
+	Negative bool
 	HH       uint64
 	MM       uint64
 	SS       uint64
 	FF       uint64
 }
 
-// newNDFRate returns new NDF rate.
-func newNDFRate(num, den int32) (*rate, error) {
-	fps := float64(num) / float64(den)
-	for _, r := range supportedNDFRates {
-		if float64(r.numerator)/float64(r.denominator) == fps {
+// gcd returns greatest common divisor of a and b.
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// reduce returns num/den reduced to lowest terms.
+func reduce(num, den int32) (int64, int64) {
+	g := gcd(int64(num), int64(den))
+	if g == 0 {
+		return int64(num), int64(den)
+	}
+	return int64(num) / g, int64(den) / g
+}
+
+// findRate returns rate matching num/den from rates.
+// num/den is compared as a reduced rational, e.g. 48000/2002 matches 24000/1001.
+func findRate(rates []*rate, num, den int32) (*rate, error) {
+	if num <= 0 || den <= 0 {
+		return nil, ErrUnsupportedFrameRate
+	}
+	n, d := reduce(num, den)
+	for _, r := range rates {
+		if int64(r.numerator) == n && int64(r.denominator) == d {
 			return r, nil
 		}
 	}
 	return nil, ErrUnsupportedFrameRate
 }
 
-// newDFRate returns new DF rate.
-func newDFRate(num, den int32) (*rate, error) {
+// findNearestRate returns rate nearest to num/den within tolerance from rates.
+func findNearestRate(rates []*rate, num, den int32, tolerance float64) (*rate, error) {
+	if num <= 0 || den <= 0 {
+		return nil, ErrUnsupportedFrameRate
+	}
 	fps := float64(num) / float64(den)
-	for _, r := range supportedDFRates {
-		if float64(r.numerator)/float64(r.denominator) == fps {
-			return r, nil
+	var nearest *rate
+	var nearestDiff float64
+	for _, r := range rates {
+		diff := math.Abs(float64(r.numerator)/float64(r.denominator) - fps)
+		if diff <= tolerance && (nearest == nil || diff < nearestDiff) {
+			nearest, nearestDiff = r, diff
 		}
 	}
-	return nil, ErrUnsupportedFrameRate
+	if nearest == nil {
+		return nil, ErrUnsupportedFrameRate
+	}
+	return nearest, nil
+}
+
+// newNDFRate returns new NDF rate.
+func newNDFRate(num, den int32) (*rate, error) {
+	ratesMu.RLock()
+	defer ratesMu.RUnlock()
+	return findRate(supportedNDFRates, num, den)
+}
+
+// newDFRate returns new DF rate.
+func newDFRate(num, den int32) (*rate, error) {
+	ratesMu.RLock()
+	defer ratesMu.RUnlock()
+	return findRate(supportedDFRates, num, den)
 }
 
 // newRate returns new rate.
@@ -103,15 +191,90 @@ func newRate(num, den int32, preferDF bool) (*rate, error) {
 	return newNDFRate(num, den)
 }
 
+// newApproxRate returns new rate nearest to num/den within tolerance.
+// Exactly matching rate is preferred, and tolerance 0 means exact matching only.
+func newApproxRate(num, den int32, preferDF bool, tolerance float64) (*rate, error) {
+	r, err := newRate(num, den, preferDF)
+	if err == nil || tolerance <= 0 {
+		return r, err
+	}
+
+	ratesMu.RLock()
+	nearest, err := findNearestRate(supportedNDFRates, num, den, tolerance)
+	if err != nil {
+		nearest, err = findNearestRate(supportedDFRates, num, den, tolerance)
+	}
+	ratesMu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return newRate(nearest.numerator, nearest.denominator, preferDF)
+}
+
 // IsSupportedFrameRate returns whether frame rate is supported.
 func IsSupportedFrameRate(num, den int32) bool {
-	_, err := newNDFRate(num, den)
+	_, err := newRate(num, den, true)
 	return err == nil
 }
 
+// RegisterFrameRate registers custom frame rate num/den.
+// Frame rate must be an integer or N*1000/1001 to be labeled with N frames per second,
+// e.g. 12.5 is ErrInvalidFrameRate.
+// dropFrames is number of frames dropped at the beginning of every minute, and 0 means NDF.
+// Frames are not dropped in every skipMinutes-th minute (e.g. 10 for 29.97DF). skipMinutes is ignored for NDF.
+// It is safe to call RegisterFrameRate concurrently, e.g. from init functions.
+func RegisterFrameRate(num, den int32, dropFrames, skipMinutes int) error {
+	if num <= 0 || den <= 0 {
+		return ErrInvalidFrameRate
+	}
+	roundFPS := int((int64(num) + int64(den) - 1) / int64(den))
+	if int64(num) != int64(roundFPS)*int64(den) && int64(num)*1001 != int64(roundFPS)*1000*int64(den) {
+		return ErrInvalidFrameRate
+	}
+	if dropFrames < 0 || dropFrames >= roundFPS {
+		return ErrInvalidFrameRate
+	}
+	if dropFrames == 0 {
+		skipMinutes = 10
+	}
+	if skipMinutes < 2 {
+		return ErrInvalidFrameRate
+	}
+	n, d := reduce(num, den)
+	num, den = int32(n), int32(d)
+
+	r := &rate{
+		roundFPS:       roundFPS,
+		numerator:      num,
+		denominator:    den,
+		dropFrames:     dropFrames,
+		cycleMinutes:   skipMinutes,
+		framesPer1Min:  roundFPS*60 - dropFrames,
+		framesPerCycle: roundFPS*60*skipMinutes - (skipMinutes-1)*dropFrames,
+	}
+
+	ratesMu.Lock()
+	defer ratesMu.Unlock()
+
+	rates := &supportedNDFRates
+	if dropFrames != 0 {
+		rates = &supportedDFRates
+	}
+	if _, err := findRate(*rates, num, den); err == nil {
+		return ErrDuplicateFrameRate
+	}
+	if len(registeredRates) >= math.MaxUint16 {
+		return ErrInvalidFrameRate
+	}
+	*rates = append(*rates, r)
+	registerRate(r)
+	return nil
+}
+
 // IsRepresentableFramesOptionParam represents IsRepresentableFrames option parameter.
 type IsRepresentableFramesOptionParam struct {
 	PreferDF bool
+	Extended bool // allow 24 hours and more
 }
 
 // IsRepresentableFramesOption represents IsRepresentableFrames option.
@@ -140,14 +303,18 @@ func IsRepresentableFrames(frames uint64, num, den int32, opts ...IsRepresentabl
 	if err != nil {
 		return false
 	}
-	return r.isRepresentableFrames(frames)
+	return r.isRepresentableFrames(frames, p.Extended)
 }
 
 // TimecodeOptionParam represents timecode option parameter.
 type TimecodeOptionParam struct {
-	PreferDF bool
-	Sep      string
-	LastSep  string
+	PreferDF  bool
+	Sep       string
+	LastSep   string
+	Tolerance float64  // maximum fps difference to accept a near-equal frame rate, 0 means exact match
+	Rounding  Rounding // rounding of FromDuration
+	Signed    bool     // allow negative timecode, e.g. -00:00:05:00
+	Extended  bool     // allow 24 hours and more, e.g. 100:00:00:00
 }
 
 // TimecodeOption represents timecode option.
@@ -174,11 +341,29 @@ func NewTimecode(frames uint64, num, den int32, opts ...TimecodeOption) (*Timeco
 	p := newTimecodeOptionParam()
 	p.applyTimecodeOption(opts...)
 
-	r, err := newRate(num, den, p.PreferDF)
+	r, err := newApproxRate(num, den, p.PreferDF, p.Tolerance)
 	if err != nil {
 		return nil, err
 	}
+	return newTimecode(frames, r, p)
+}
 
+// NewTimecodeWithRate returns new Timecode with FrameRate.
+// PreferDF option is ignored, DropFrame of FrameRate is used instead.
+func NewTimecodeWithRate(frames uint64, fr FrameRate, opts ...TimecodeOption) (*Timecode, error) {
+	p := newTimecodeOptionParam()
+	p.applyTimecodeOption(opts...)
+	p.PreferDF = fr.DropFrame
+
+	r, err := fr.rate()
+	if err != nil {
+		return nil, err
+	}
+	return newTimecode(frames, r, p)
+}
+
+// newTimecode returns new Timecode from rate and TimecodeOptionParam.
+func newTimecode(frames uint64, r *rate, p TimecodeOptionParam) (*Timecode, error) {
 	lastSep := p.LastSep
 	if r.dropFrames == 0 {
 		lastSep = p.Sep
@@ -186,6 +371,8 @@ func NewTimecode(frames uint64, num, den int32, opts ...TimecodeOption) (*Timeco
 
 	tc, err := Reset(&Timecode{
 		preferDF: p.PreferDF,
+		signed:   p.Signed,
+		extended: p.Extended,
 		sep:      p.Sep,
 		lastSep:  lastSep,
 		r:        r,
@@ -196,11 +383,109 @@ func NewTimecode(frames uint64, num, den int32, opts ...TimecodeOption) (*Timeco
 	return tc, nil
 }
 
+// Rounding represents rounding mode of time to frames conversion.
+type Rounding int
+
+const (
+	RoundFloor   Rounding = iota // round down to the frame being displayed
+	RoundNearest                 // round to the nearest frame, half up
+	RoundCeil                    // round up to the next frame
+)
+
+// mulDiv returns a*b/c rounded by rounding without intermediate overflow.
+// ok is false if the result overflows uint64.
+func mulDiv(a, b, c uint64, rounding Rounding) (q uint64, ok bool) {
+	hi, lo := bits.Mul64(a, b)
+	if hi >= c {
+		return 0, false
+	}
+	q, rem := bits.Div64(hi, lo, c)
+	if rounding == RoundNearest && rem >= c-rem || rounding == RoundCeil && rem != 0 {
+		if q == math.MaxUint64 {
+			return 0, false
+		}
+		q++
+	}
+	return q, true
+}
+
+// FromDuration returns new Timecode from duration from zero-origin.
+// Duration is converted to frames by Rounding option, which defaults to RoundFloor.
+func FromDuration(d time.Duration, num, den int32, opts ...TimecodeOption) (*Timecode, error) {
+	p := newTimecodeOptionParam()
+	p.applyTimecodeOption(opts...)
+
+	r, err := newApproxRate(num, den, p.PreferDF, p.Tolerance)
+	if err != nil {
+		return nil, err
+	}
+	return fromDuration(d, r, p)
+}
+
+// FromRealTime returns new Timecode whose real elapsed time is closest to wall-clock offset d.
+// It is FromDuration with Rounding defaulting to RoundNearest.
+func FromRealTime(d time.Duration, num, den int32, opts ...TimecodeOption) (*Timecode, error) {
+	nearest := func(p *TimecodeOptionParam) {
+		p.Rounding = RoundNearest
+	}
+	return FromDuration(d, num, den, append([]TimecodeOption{nearest}, opts...)...)
+}
+
+// FromDurationWithRate returns new Timecode from duration from zero-origin and FrameRate.
+// PreferDF option is ignored, DropFrame of FrameRate is used instead.
+func FromDurationWithRate(d time.Duration, fr FrameRate, opts ...TimecodeOption) (*Timecode, error) {
+	p := newTimecodeOptionParam()
+	p.applyTimecodeOption(opts...)
+	p.PreferDF = fr.DropFrame
+
+	r, err := fr.rate()
+	if err != nil {
+		return nil, err
+	}
+	return fromDuration(d, r, p)
+}
+
+// fromDuration returns new Timecode from duration, rate and TimecodeOptionParam.
+func fromDuration(d time.Duration, r *rate, p TimecodeOptionParam) (*Timecode, error) {
+	if d < 0 {
+		if !p.Signed {
+			return nil, ErrUnderflowFrames
+		}
+		// rounding is applied to the magnitude, so floor and ceil are swapped
+		switch p.Rounding {
+		case RoundFloor:
+			p.Rounding = RoundCeil
+		case RoundCeil:
+			p.Rounding = RoundFloor
+		}
+	}
+	abs := uint64(d)
+	if d < 0 {
+		abs = uint64(-d)
+	}
+	frames, ok := mulDiv(abs, uint64(r.numerator), uint64(r.denominator)*uint64(time.Second), p.Rounding)
+	if !ok {
+		return nil, ErrTooManyFrames
+	}
+	tc, err := newTimecode(frames, r, p)
+	if err != nil {
+		return nil, err
+	}
+	tc.Negative = d < 0 && frames != 0
+	return tc, nil
+}
+
 // TimecodeOptionParam represents timecode option parameter.
 type ParseTimecodeOptionParam struct {
-	PreferDF bool
-	Sep      string
-	LastSep  string
+	PreferDF  bool
+	Sep       string
+	LastSep   string
+	Tolerance float64   // maximum fps difference to accept a near-equal frame rate, 0 means exact match
+	Signed    bool      // allow negative timecode, e.g. -00:00:05:00
+	Extended  bool      // allow 24 hours and more, e.g. 100:00:00:00
+	Layout    string    // parse by layout instead of separated fields, e.g. LayoutCompact
+	Mode      ParseMode // treatment of labels which do not exist at the frame rate
+	DetectDF  bool      // infer DF from last separator ";", "." or "," and NDF from ":", ignored with Layout
 }
 
 // ParseTimecodeOption represents parse timecode option.
@@ -221,31 +506,38 @@ func (p *ParseTimecodeOptionParam) applyParseTimecodeOption(opts ...ParseTimecod
 }
 
 // ParseTimecode returns new Timecode from formatted string.
+// With DetectDF option, last separator of DF contradicting PreferDF=false is ParseError wrapping ErrMismatchFrameRate.
 func ParseTimecode(s string, num, den int32, opts ...ParseTimecodeOption) (*Timecode, error) {
 	p := newParseTimecodeOptionParam()
 	p.applyParseTimecodeOption(opts...)
 
-	r, err := newRate(num, den, p.PreferDF)
+	r, err := newApproxRate(num, den, p.PreferDF, p.Tolerance)
 	if err != nil {
 		return nil, err
 	}
+	return parseTimecode(s, r, false, p)
+}
 
-	// pattern: HH Sep1 MM Sep2 SS Sep3 FF
-	// match  : 1  2    3  4    5  6    7
-	match := timecodePattern.FindStringSubmatch(s)
-	if len(match) != 8 || match[2] != match[4] {
-		return nil, ErrInvalidTimecode
-	}
+// ParseTimecodeWithRate returns new Timecode from formatted string and FrameRate.
+// PreferDF option is ignored, DropFrame of FrameRate is used instead.
+// With DetectDF option, last separator contradicting DropFrame of FrameRate is ParseError wrapping ErrMismatchFrameRate.
+func ParseTimecodeWithRate(s string, fr FrameRate, opts ...ParseTimecodeOption) (*Timecode, error) {
+	p := newParseTimecodeOptionParam()
+	p.applyParseTimecodeOption(opts...)
+	p.PreferDF = fr.DropFrame
 
-	hh, _ := strconv.Atoi(match[1])
-	sep := match[2]
-	mm, _ := strconv.Atoi(match[3])
-	ss, _ := strconv.Atoi(match[5])
-	lastSep := match[6]
-	ff, _ := strconv.Atoi(match[7])
+	r, err := fr.rate()
+	if err != nil {
+		return nil, err
+	}
+	return parseTimecode(s, r, true, p)
+}
 
-	if ff < r.dropFrames && mm%10 != 0 {
-		ff = r.dropFrames
+// newParsedTimecode returns new Timecode from parsed fields.
+// Fields must be validated by caller.
+func newParsedTimecode(negative bool, hh, mm, ss, ff uint64, sep, lastSep string, r *rate, p ParseTimecodeOptionParam) *Timecode {
+	if r.isDropped(hh, mm, ss, ff) {
+		ff = uint64(r.dropFrames)
 	}
 	if r.dropFrames == 0 {
 		lastSep = sep
@@ -253,14 +545,17 @@ func ParseTimecode(s string, num, den int32, opts ...ParseTimecodeOption) (*Time
 
 	return &Timecode{
 		preferDF: p.PreferDF,
+		signed:   p.Signed,
+		extended: p.Extended,
 		sep:      sep,
 		lastSep:  lastSep,
 		r:        r,
-		HH:       uint64(hh),
-		MM:       uint64(mm),
-		SS:       uint64(ss),
-		FF:       uint64(ff),
-	}, nil
+		Negative: negative && hh+mm+ss+ff != 0,
+		HH:       hh,
+		MM:       mm,
+		SS:       ss,
+		FF:       ff,
+	}
 }
 
 // Reset returns new Timecode from Timecode and frames.
@@ -270,26 +565,73 @@ func Reset(tc *Timecode, frames uint64) (*Timecode, error) {
 	}
 
 	new := *tc
+	new.Negative = false
 
-	if !new.r.isRepresentableFrames(frames) {
+	if !new.r.isRepresentableFrames(frames, new.extended) {
 		return nil, ErrTooManyFrames
 	}
 
-	d := frames / uint64(new.r.framesPer10Min)
-	m := frames % uint64(new.r.framesPer10Min)
-	df := uint64(new.r.dropFrames)
-	f := frames + 9*df*d
+	new.HH, new.MM, new.SS, new.FF = new.r.label(frames)
+
+	return &new, nil
+}
+
+// isDropped returns whether label is dropped by drop-frame.
+func (r *rate) isDropped(hh, mm, ss, ff uint64) bool {
+	return ss == 0 && ff < uint64(r.dropFrames) && (hh*60+mm)%uint64(r.cycleMinutes) != 0
+}
+
+// label returns HH, MM, SS and FF of frames.
+func (r *rate) label(frames uint64) (hh, mm, ss, ff uint64) {
+	d := frames / uint64(r.framesPerCycle)
+	m := frames % uint64(r.framesPerCycle)
+	df := uint64(r.dropFrames)
+	f := frames + uint64(r.cycleMinutes-1)*df*d
 	if m > df {
-		f += df * ((m - df) / uint64(new.r.framesPer1Min))
+		f += df * ((m - df) / uint64(r.framesPer1Min))
 	}
 
-	fps := uint64(new.r.roundFPS)
-	new.FF = f % fps
-	new.SS = f / fps % 60
-	new.MM = f / (fps * 60) % 60
-	new.HH = f / (fps * 3600)
+	fps := uint64(r.roundFPS)
+	ff = f % fps
+	ss = f / fps % 60
+	mm = f / (fps * 60) % 60
+	hh = f / (fps * 3600)
+	return hh, mm, ss, ff
+}
 
-	return &new, nil
+// resetSigned returns new Timecode from Timecode and signed frames.
+// Negative frames are allowed only for signed Timecode.
+func resetSigned(tc *Timecode, frames int64) (*Timecode, error) {
+	if tc == nil {
+		return nil, ErrNilTimecode
+	}
+	if frames >= 0 {
+		return Reset(tc, uint64(frames))
+	}
+	if !tc.signed {
+		return nil, ErrUnderflowFrames
+	}
+	new, err := Reset(tc, uint64(-frames))
+	if err != nil {
+		return nil, err
+	}
+	new.Negative = true
+	return new, nil
+}
+
+// resetWrap returns new Timecode from Timecode and signed frames modulo 24 hours.
+func resetWrap(tc *Timecode, frames int64) (*Timecode, bool, error) {
+	day := int64(tc.r.framesPerDay())
+	wrapped := frames < 0 || frames >= day
+	frames %= day
+	if frames < 0 {
+		frames += day
+	}
+	new, err := Reset(tc, uint64(frames))
+	if err != nil {
+		return nil, false, err
+	}
+	return new, wrapped, nil
 }
 
 // equal returns whether rate is equal.
@@ -300,12 +642,38 @@ func (r *rate) equal(other *rate) bool {
 	return r.numerator == other.numerator && r.denominator == other.denominator && r.dropFrames == other.dropFrames
 }
 
+// duration returns duration of frames rounded to the nearest nanosecond.
+func (r *rate) duration(frames uint64) time.Duration {
+	ns, ok := mulDiv(frames, uint64(r.denominator)*uint64(time.Second), uint64(r.numerator), RoundNearest)
+	if !ok || ns > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(ns)
+}
+
+// framesPerHours returns number of frames in hours.
+func (r *rate) framesPerHours(hours int) uint64 {
+	minutes := hours * 60
+	undropped := (minutes + r.cycleMinutes - 1) / r.cycleMinutes
+	return uint64(minutes*60*r.roundFPS - (minutes-undropped)*r.dropFrames)
+}
+
+// framesPerDay returns number of frames in 24 hours.
+func (r *rate) framesPerDay() uint64 {
+	return r.framesPerHours(24)
+}
+
 // isRepresentableFrames returns whether frames is representable.
-func (r *rate) isRepresentableFrames(frames uint64) bool {
-	return frames < uint64(24*6*r.framesPer10Min)
+// Extended mode allows frames up to maxExtendedHours.
+func (r *rate) isRepresentableFrames(frames uint64, extended bool) bool {
+	if extended {
+		return frames < r.framesPerHours(maxExtendedHours)
+	}
+	return frames < r.framesPerDay()
 }
 
 // Frames returns number of frames.
+// For negative Timecode, it returns number of frames of the absolute value. See also SignedFrames.
 func (tc *Timecode) Frames() uint64 {
 	var frames uint64
 	frames += tc.HH * 3600 * uint64(tc.r.roundFPS)
@@ -313,19 +681,55 @@ func (tc *Timecode) Frames() uint64 {
 	frames += tc.SS * uint64(tc.r.roundFPS)
 	frames += tc.FF
 
-	framesPer10Min := uint64(tc.r.roundFPS) * 60 * 10
-	framesPer1Min := framesPer10Min / 10
+	framesPerCycle := uint64(tc.r.roundFPS) * 60 * uint64(tc.r.cycleMinutes)
+	framesPer1Min := uint64(tc.r.roundFPS) * 60
 
 	var df uint64
-	df += (frames / framesPer10Min) * uint64(tc.r.dropFrames) * 9
-	df += (frames % framesPer10Min) / framesPer1Min * uint64(tc.r.dropFrames)
+	df += (frames / framesPerCycle) * uint64(tc.r.dropFrames) * uint64(tc.r.cycleMinutes-1)
+	df += (frames % framesPerCycle) / framesPer1Min * uint64(tc.r.dropFrames)
 
 	return frames - df
 }
 
-// Duration returns duration from zero-origin.
+// SignedFrames returns signed number of frames, which is negative for negative Timecode.
+func (tc *Timecode) SignedFrames() int64 {
+	if tc.Negative {
+		return -int64(tc.Frames())
+	}
+	return int64(tc.Frames())
+}
+
+// Duration returns duration from zero-origin, i.e. real elapsed time.
+// It is computed exactly from the rational frame rate and rounded to the nearest nanosecond.
 func (tc *Timecode) Duration() time.Duration {
-	return time.Duration((float64(tc.Frames()) / float64(tc.r.actualFPS)) * float64(time.Second))
+	d := tc.r.duration(tc.Frames())
+	if tc.Negative {
+		return -d
+	}
+	return d
+}
+
+// LabelDuration returns nominal duration of timecode label, i.e. HH:MM:SS plus FF as a fraction of a second.
+// e.g. 01:00:00:00 is 1h regardless of frame rate.
+func (tc *Timecode) LabelDuration() time.Duration {
+	seconds := tc.HH*3600 + tc.MM*60 + tc.SS
+	ns, _ := mulDiv(tc.FF, uint64(time.Second), uint64(tc.r.roundFPS), RoundNearest)
+	d := time.Duration(seconds)*time.Second + time.Duration(ns)
+	if tc.Negative {
+		return -d
+	}
+	return d
+}
+
+// Drift returns real elapsed time minus label time.
+// e.g. 01:00:00:00 at 29.97NDF drifts by +3.6s, while 29.97DF stays within a few milliseconds.
+func (tc *Timecode) Drift() time.Duration {
+	return tc.Duration() - tc.LabelDuration()
+}
+
+// FrameRate returns frame rate.
+func (tc *Timecode) FrameRate() FrameRate {
+	return tc.r.frameRate()
 }
 
 // Framerate denominator.
@@ -343,7 +747,7 @@ func (tc *Timecode) Add(other *Timecode) (*Timecode, error) {
 	if !tc.r.equal(other.r) {
 		return nil, ErrMismatchFrameRate
 	}
-	return Reset(tc, tc.Frames()+other.Frames())
+	return resetSigned(tc, tc.SignedFrames()+other.SignedFrames())
 }
 
 // Sub Timecode and Timecode and return new Timecode.
@@ -351,30 +755,72 @@ func (tc *Timecode) Sub(other *Timecode) (*Timecode, error) {
 	if !tc.r.equal(other.r) {
 		return nil, ErrMismatchFrameRate
 	}
-	if tc.Frames() < other.Frames() {
-		return nil, ErrUnderflowFrames
-	}
-	return Reset(tc, tc.Frames()-other.Frames())
+	return resetSigned(tc, tc.SignedFrames()-other.SignedFrames())
 }
 
 // Add Timecode and frames and return new Timecode.
 func (tc *Timecode) AddFrames(frames uint64) (*Timecode, error) {
-	return Reset(tc, tc.Frames()+frames)
+	if frames > math.MaxInt64-tc.Frames() {
+		return nil, ErrTooManyFrames
+	}
+	return resetSigned(tc, tc.SignedFrames()+int64(frames))
 }
 
 // Sub Timecode and frames and return new Timecode.
 func (tc *Timecode) SubFrames(frames uint64) (*Timecode, error) {
-	if tc.Frames() < frames {
-		return nil, ErrUnderflowFrames
+	if frames > math.MaxInt64-tc.Frames() {
+		if !tc.signed {
+			return nil, ErrUnderflowFrames
+		}
+		return nil, ErrTooManyFrames
+	}
+	return resetSigned(tc, tc.SignedFrames()-int64(frames))
+}
+
+// AddWrap adds Timecode and Timecode modulo 24 hours and returns new Timecode.
+// wrapped reports whether the result rolled over midnight.
+func (tc *Timecode) AddWrap(other *Timecode) (new *Timecode, wrapped bool, err error) {
+	if !tc.r.equal(other.r) {
+		return nil, false, ErrMismatchFrameRate
+	}
+	return resetWrap(tc, tc.SignedFrames()+other.SignedFrames())
+}
+
+// SubWrap subtracts Timecode from Timecode modulo 24 hours and returns new Timecode.
+// wrapped reports whether the result rolled back over midnight.
+func (tc *Timecode) SubWrap(other *Timecode) (new *Timecode, wrapped bool, err error) {
+	if !tc.r.equal(other.r) {
+		return nil, false, ErrMismatchFrameRate
 	}
-	return Reset(tc, tc.Frames()-frames)
+	return resetWrap(tc, tc.SignedFrames()-other.SignedFrames())
+}
+
+// AddFramesWrap adds frames to Timecode modulo 24 hours and returns new Timecode.
+// wrapped reports whether the result rolled over midnight.
+func (tc *Timecode) AddFramesWrap(frames uint64) (new *Timecode, wrapped bool, err error) {
+	day := tc.r.framesPerDay()
+	new, wrapped, err = resetWrap(tc, tc.SignedFrames()+int64(frames%day))
+	return new, wrapped || frames >= day, err
+}
+
+// SubFramesWrap subtracts frames from Timecode modulo 24 hours and returns new Timecode.
+// wrapped reports whether the result rolled back over midnight.
+func (tc *Timecode) SubFramesWrap(frames uint64) (new *Timecode, wrapped bool, err error) {
+	day := tc.r.framesPerDay()
+	new, wrapped, err = resetWrap(tc, tc.SignedFrames()-int64(frames%day))
+	return new, wrapped || frames >= day, err
 }
 
 // String returns Timecode formatted string.
-// e.g. 01:23:45:28
+// e.g. 01:23:45:28, -00:00:05:00
 func (tc *Timecode) String() string {
+	sign := ""
+	if tc.Negative {
+		sign = "-"
+	}
 	return fmt.Sprintf(
-		"%02d%s%02d%s%02d%s%02d",
+		"%s%02d%s%02d%s%02d%s%02d",
+		sign,
 		tc.HH,
 		tc.sep,
 		tc.MM,
@@ -2,11 +2,39 @@ package timecode
 
 import (
 	"math"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+var registerErrs []error
+
+func init() {
+	// custom frame rates are registered once per test binary
+	params := [][4]int{
+		{96, 1, 0, 0},        // 96
+		{48000, 1001, 0, 0},  // 47.952NDF
+		{48000, 1001, 4, 10}, // 47.952DF
+		{96000, 1001, 4, 5},  // 95.904DF (every 5 minutes)
+		{6, 1, 0, 0},         // 6
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, p := range params {
+		wg.Add(1)
+		go func(p [4]int) {
+			defer wg.Done()
+			err := RegisterFrameRate(int32(p[0]), int32(p[1]), p[2], p[3])
+			mu.Lock()
+			registerErrs = append(registerErrs, err)
+			mu.Unlock()
+		}(p)
+	}
+	wg.Wait()
+}
+
 func TestNewRate(t *testing.T) {
 	t.Run("NaN", func(t *testing.T) {
 		_, err := newRate(1, 0, true)
@@ -26,7 +54,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 24, r.roundFPS)
 		assert.Equal(t, 0, r.dropFrames)
 		assert.Equal(t, 24*60, r.framesPer1Min)
-		assert.Equal(t, 24*600, r.framesPer10Min)
+		assert.Equal(t, 24*600, r.framesPerCycle)
 	})
 	t.Run("24fps", func(t *testing.T) {
 		r, err := newRate(24, 1, true)
@@ -34,7 +62,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 24, r.roundFPS)
 		assert.Equal(t, 0, r.dropFrames)
 		assert.Equal(t, 24*60, r.framesPer1Min)
-		assert.Equal(t, 24*600, r.framesPer10Min)
+		assert.Equal(t, 24*600, r.framesPerCycle)
 	})
 	t.Run("25fps", func(t *testing.T) {
 		r, err := newRate(25, 1, true)
@@ -42,7 +70,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 25, r.roundFPS)
 		assert.Equal(t, 0, r.dropFrames)
 		assert.Equal(t, 25*60, r.framesPer1Min)
-		assert.Equal(t, 25*600, r.framesPer10Min)
+		assert.Equal(t, 25*600, r.framesPerCycle)
 	})
 	t.Run("29.97fps", func(t *testing.T) {
 		r, err := newRate(30000, 1001, true)
@@ -50,7 +78,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 30, r.roundFPS)
 		assert.Equal(t, 2, r.dropFrames)
 		assert.Equal(t, 30*60-2, r.framesPer1Min)
-		assert.Equal(t, 30*600-9*2, r.framesPer10Min)
+		assert.Equal(t, 30*600-9*2, r.framesPerCycle)
 	})
 	t.Run("30fps", func(t *testing.T) {
 		r, err := newRate(30, 1, true)
@@ -58,7 +86,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 30, r.roundFPS)
 		assert.Equal(t, 0, r.dropFrames)
 		assert.Equal(t, 30*60, r.framesPer1Min)
-		assert.Equal(t, 30*600, r.framesPer10Min)
+		assert.Equal(t, 30*600, r.framesPerCycle)
 	})
 	t.Run("48fps", func(t *testing.T) {
 		r, err := newRate(48, 1, true)
@@ -66,7 +94,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 48, r.roundFPS)
 		assert.Equal(t, 0, r.dropFrames)
 		assert.Equal(t, 48*60, r.framesPer1Min)
-		assert.Equal(t, 48*600, r.framesPer10Min)
+		assert.Equal(t, 48*600, r.framesPerCycle)
 	})
 	t.Run("50fps", func(t *testing.T) {
 		r, err := newRate(50, 1, true)
@@ -74,7 +102,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 50, r.roundFPS)
 		assert.Equal(t, 0, r.dropFrames)
 		assert.Equal(t, 50*60, r.framesPer1Min)
-		assert.Equal(t, 50*600, r.framesPer10Min)
+		assert.Equal(t, 50*600, r.framesPerCycle)
 	})
 	t.Run("59.94fps", func(t *testing.T) {
 		r, err := newRate(60000, 1001, true)
@@ -82,7 +110,7 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 60, r.roundFPS)
 		assert.Equal(t, 4, r.dropFrames)
 		assert.Equal(t, 60*60-4, r.framesPer1Min)
-		assert.Equal(t, 60*600-9*4, r.framesPer10Min)
+		assert.Equal(t, 60*600-9*4, r.framesPerCycle)
 	})
 	t.Run("60fps", func(t *testing.T) {
 		r, err := newRate(60, 1, true)
@@ -90,7 +118,67 @@ func TestNewRate(t *testing.T) {
 		assert.Equal(t, 60, r.roundFPS)
 		assert.Equal(t, 0, r.dropFrames)
 		assert.Equal(t, 60*60, r.framesPer1Min)
-		assert.Equal(t, 60*600, r.framesPer10Min)
+		assert.Equal(t, 60*600, r.framesPerCycle)
+	})
+	t.Run("100fps", func(t *testing.T) {
+		r, err := newRate(100, 1, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 100, r.roundFPS)
+		assert.Equal(t, 0, r.dropFrames)
+		assert.Equal(t, 100*60, r.framesPer1Min)
+		assert.Equal(t, 100*600, r.framesPerCycle)
+	})
+	t.Run("119.88fps", func(t *testing.T) {
+		r, err := newRate(120000, 1001, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 120, r.roundFPS)
+		assert.Equal(t, 8, r.dropFrames)
+		assert.Equal(t, 120*60-8, r.framesPer1Min)
+		assert.Equal(t, 120*600-9*8, r.framesPerCycle)
+	})
+	t.Run("119.88fps (NDF)", func(t *testing.T) {
+		r, err := newRate(120000, 1001, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 120, r.roundFPS)
+		assert.Equal(t, 0, r.dropFrames)
+		assert.Equal(t, 120*60, r.framesPer1Min)
+		assert.Equal(t, 120*600, r.framesPerCycle)
+	})
+	t.Run("120fps", func(t *testing.T) {
+		r, err := newRate(120, 1, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 120, r.roundFPS)
+		assert.Equal(t, 0, r.dropFrames)
+		assert.Equal(t, 120*60, r.framesPer1Min)
+		assert.Equal(t, 120*600, r.framesPerCycle)
+	})
+	t.Run("non-reduced 23.976fps", func(t *testing.T) {
+		r, err := newRate(48000, 2002, true)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(24000), r.numerator)
+		assert.Equal(t, int32(1001), r.denominator)
+	})
+	t.Run("non-reduced 29.97fps", func(t *testing.T) {
+		r, err := newRate(60000, 2002, true)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(30000), r.numerator)
+		assert.Equal(t, 2, r.dropFrames)
+	})
+	t.Run("non-reduced 25fps", func(t *testing.T) {
+		r, err := newRate(50, 2, true)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(25), r.numerator)
+		assert.Equal(t, int32(1), r.denominator)
+	})
+	t.Run("error/negative", func(t *testing.T) {
+		r, err := newRate(-30000, -1001, true)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+		assert.Nil(t, r)
+	})
+	t.Run("error/decimal 29.97fps", func(t *testing.T) {
+		r, err := newRate(2997, 100, true)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+		assert.Nil(t, r)
 	})
 	t.Run("error/23.995fps", func(t *testing.T) {
 		r, err := newRate(29995, 1000, true)
@@ -170,7 +258,7 @@ func TestNewTimecodeNDF(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "00:10:00:00", tc.String())
 		assert.Equal(t, uint64(1440*10), tc.Frames())
-		assert.Equal(t, 600.601, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, 600.6, math.Round(tc.Duration().Seconds()*1000)/1000)
 
 		tc, err = NewTimecode(1440*10+1, 24000, 1001, assumeDF)
 		assert.NoError(t, err)
@@ -292,7 +380,7 @@ func TestNewTimecodeNDF(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "00:10:00:00", tc.String())
 		assert.Equal(t, uint64(1800*10), tc.Frames())
-		assert.Equal(t, 600.601, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, 600.6, math.Round(tc.Duration().Seconds()*1000)/1000)
 
 		tc, err = NewTimecode(1800*10+1, 30000, 1001, assumeNDF)
 		assert.NoError(t, err)
@@ -304,7 +392,7 @@ func TestNewTimecodeNDF(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "23:59:59:29", tc.String())
 		assert.Equal(t, maxFrames, tc.Frames())
-		assert.Equal(t, 86486.453, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, 86486.367, math.Round(tc.Duration().Seconds()*1000)/1000)
 
 		tc, err = NewTimecode(maxFrames+1, 30000, 1001, assumeNDF)
 		assert.Equal(t, ErrTooManyFrames, err)
@@ -461,7 +549,7 @@ func TestNewTimecodeNDF(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "00:10:00:00", tc.String())
 		assert.Equal(t, uint64(3600*10), tc.Frames())
-		assert.Equal(t, 600.601, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, 600.6, math.Round(tc.Duration().Seconds()*1000)/1000)
 
 		tc, err = NewTimecode(3600*10+1, 60000, 1001, assumeNDF)
 		assert.NoError(t, err)
@@ -473,7 +561,7 @@ func TestNewTimecodeNDF(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "23:59:59:59", tc.String())
 		assert.Equal(t, maxFrames, tc.Frames())
-		assert.Equal(t, 86486.47, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, 86486.383, math.Round(tc.Duration().Seconds()*1000)/1000)
 
 		tc, err = NewTimecode(maxFrames+1, 60000, 1001, assumeNDF)
 		assert.Equal(t, ErrTooManyFrames, err)
@@ -525,6 +613,78 @@ func TestNewTimecodeNDF(t *testing.T) {
 		assert.Equal(t, ErrTooManyFrames, err)
 		assert.Nil(t, tc)
 	})
+	t.Run("100fps", func(t *testing.T) {
+		tc, err := NewTimecode(5999, 100, 1, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59:99", tc.String())
+		assert.Equal(t, uint64(5999), tc.Frames())
+		assert.Equal(t, 59.99, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, int32(100), tc.FramerateNumerator())
+		assert.Equal(t, int32(1), tc.FramerateDenominator())
+
+		tc, err = NewTimecode(6000, 100, 1, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", tc.String())
+		assert.Equal(t, uint64(6000), tc.Frames())
+
+		maxFrames := uint64(24*6*(6000*10)) - 1
+		tc, err = NewTimecode(maxFrames, 100, 1, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "23:59:59:99", tc.String())
+		assert.Equal(t, maxFrames, tc.Frames())
+
+		tc, err = NewTimecode(maxFrames+1, 100, 1, assumeDF)
+		assert.Equal(t, ErrTooManyFrames, err)
+		assert.Nil(t, tc)
+	})
+	t.Run("119.88fps (NDF)", func(t *testing.T) {
+		tc, err := NewTimecode(7199, 120000, 1001, assumeNDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59:119", tc.String())
+		assert.Equal(t, uint64(7199), tc.Frames())
+		assert.Equal(t, 60.052, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, int32(120000), tc.FramerateNumerator())
+		assert.Equal(t, int32(1001), tc.FramerateDenominator())
+
+		tc, err = NewTimecode(7200, 120000, 1001, assumeNDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", tc.String())
+		assert.Equal(t, uint64(7200), tc.Frames())
+
+		maxFrames := uint64(24*6*(7200*10)) - 1
+		tc, err = NewTimecode(maxFrames, 120000, 1001, assumeNDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "23:59:59:119", tc.String())
+		assert.Equal(t, maxFrames, tc.Frames())
+
+		tc, err = NewTimecode(maxFrames+1, 120000, 1001, assumeNDF)
+		assert.Equal(t, ErrTooManyFrames, err)
+		assert.Nil(t, tc)
+	})
+	t.Run("120fps", func(t *testing.T) {
+		tc, err := NewTimecode(7199, 120, 1, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59:119", tc.String())
+		assert.Equal(t, uint64(7199), tc.Frames())
+		assert.Equal(t, 59.992, math.Round(tc.Duration().Seconds()*1000)/1000)
+		assert.Equal(t, int32(120), tc.FramerateNumerator())
+		assert.Equal(t, int32(1), tc.FramerateDenominator())
+
+		tc, err = NewTimecode(7200, 120, 1, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", tc.String())
+		assert.Equal(t, uint64(7200), tc.Frames())
+
+		maxFrames := uint64(24*6*(7200*10)) - 1
+		tc, err = NewTimecode(maxFrames, 120, 1, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "23:59:59:119", tc.String())
+		assert.Equal(t, maxFrames, tc.Frames())
+
+		tc, err = NewTimecode(maxFrames+1, 120, 1, assumeDF)
+		assert.Equal(t, ErrTooManyFrames, err)
+		assert.Nil(t, tc)
+	})
 }
 
 func TestNewTimecodeDF(t *testing.T) {
@@ -624,6 +784,47 @@ func TestNewTimecodeDF(t *testing.T) {
 		assert.Equal(t, ErrTooManyFrames, err)
 		assert.Nil(t, tc)
 	})
+	t.Run("120DF", func(t *testing.T) {
+		tc, err := NewTimecode(7192, 120000, 1001, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59:112", tc.String())
+		assert.Equal(t, uint64(7192), tc.Frames())
+
+		tc, err = NewTimecode(7199, 120000, 1001, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59:119", tc.String())
+		assert.Equal(t, uint64(7199), tc.Frames())
+
+		tc, err = NewTimecode(7200, 120000, 1001, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:08", tc.String())
+		assert.Equal(t, uint64(7200), tc.Frames())
+
+		tc, err = NewTimecode(7200+7192*8, 120000, 1001, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:09:00:08", tc.String())
+		assert.Equal(t, uint64(7200+7192*8), tc.Frames())
+
+		tc, err = NewTimecode(7200+7192*9, 120000, 1001, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:10:00:00", tc.String())
+		assert.Equal(t, uint64(7200+7192*9), tc.Frames())
+
+		tc, err = NewTimecode(7200+7192*9+7200, 120000, 1001, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:11:00:08", tc.String())
+		assert.Equal(t, uint64(7200+7192*9+7200), tc.Frames())
+
+		maxFrames := uint64(24*6*(7200+7192*9)) - 1
+		tc, err = NewTimecode(maxFrames, 120000, 1001, assumeDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "23:59:59:119", tc.String())
+		assert.Equal(t, maxFrames, tc.Frames())
+
+		tc, err = NewTimecode(maxFrames+1, 120000, 1001, assumeDF)
+		assert.Equal(t, ErrTooManyFrames, err)
+		assert.Nil(t, tc)
+	})
 }
 
 func TestParseTimecode(t *testing.T) {
@@ -662,13 +863,34 @@ func TestParseTimecode(t *testing.T) {
 	t.Run("ParseTimecode/24h", func(t *testing.T) {
 		tc, err := ParseTimecode("24:01:00;00", 24, 1)
 		assert.Nil(t, tc)
-		assert.Equal(t, ErrInvalidTimecode, err)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
 	})
 	t.Run("ParseTimecode/skip timecode", func(t *testing.T) {
 		tc, err := ParseTimecode("00:09:00:03", 60000, 1001)
 		assert.NoError(t, err)
 		assert.Equal(t, "00:09:00:04", tc.String())
 	})
+	t.Run("ParseTimecode/119.88DF", func(t *testing.T) {
+		tc, err := ParseTimecode("00:01:00;03", 120000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00;08", tc.String())
+		assert.Equal(t, uint64(7200), tc.Frames())
+
+		tc, err = ParseTimecode("00:00:59;119", 120000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:59;119", tc.String())
+		assert.Equal(t, uint64(7199), tc.Frames())
+	})
+	t.Run("ParseTimecode/100fps", func(t *testing.T) {
+		tc, err := ParseTimecode("00:00:01:99", 100, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(199), tc.Frames())
+	})
+	t.Run("ParseTimecode/overflow 120fps", func(t *testing.T) {
+		tc, err := ParseTimecode("00:00:01:120", 120, 1)
+		assert.Nil(t, tc)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+	})
 	t.Run("ParseTimecode/overflow", func(t *testing.T) {
 		tc, err := ParseTimecode("00:09:00:99", 60000, 1001)
 		assert.Error(t, err)
@@ -677,27 +899,27 @@ func TestParseTimecode(t *testing.T) {
 	t.Run("ParseTimecode/lacks character", func(t *testing.T) {
 		tc, err := ParseTimecode("0:01:00:00", 24, 1)
 		assert.Nil(t, tc)
-		assert.Equal(t, ErrInvalidTimecode, err)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
 	})
 	t.Run("ParseTimecode/superfluous character", func(t *testing.T) {
 		tc, err := ParseTimecode("0:01:000:00", 24, 1)
 		assert.Nil(t, tc)
-		assert.Equal(t, ErrInvalidTimecode, err)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
 	})
 	t.Run("ParseTimecode/invalid character", func(t *testing.T) {
 		tc, err := ParseTimecode("00:01:00:0x", 24, 1)
 		assert.Nil(t, tc)
-		assert.Equal(t, ErrInvalidTimecode, err)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
 	})
 	t.Run("ParseTimecode/invalid separator", func(t *testing.T) {
 		tc, err := ParseTimecode("00:01?00:0x", 24, 1)
 		assert.Nil(t, tc)
-		assert.Equal(t, ErrInvalidTimecode, err)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
 	})
 	t.Run("ParseTimecode/separators are inconsistent", func(t *testing.T) {
 		tc, err := ParseTimecode("00;01:00;00", 24, 1)
 		assert.Nil(t, tc)
-		assert.Equal(t, ErrInvalidTimecode, err)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
 	})
 }
 
@@ -831,3 +1053,545 @@ func TestTimecodeOption(t *testing.T) {
 		assert.Equal(t, "00.00.59.56", tc.String())
 	})
 }
+
+func TestRegisterFrameRate(t *testing.T) {
+	t.Run("concurrent registration", func(t *testing.T) {
+		assert.Len(t, registerErrs, 5)
+		for _, err := range registerErrs {
+			assert.NoError(t, err)
+		}
+	})
+	t.Run("supported", func(t *testing.T) {
+		assert.True(t, IsSupportedFrameRate(96, 1))
+		assert.True(t, IsSupportedFrameRate(48000, 1001))
+		assert.True(t, IsSupportedFrameRate(96000, 1001))
+		assert.True(t, IsSupportedFrameRate(6, 1))
+		assert.False(t, IsSupportedFrameRate(7, 1))
+		assert.True(t, IsRepresentableFrames(6*86400-1, 6, 1))
+		assert.False(t, IsRepresentableFrames(6*86400, 6, 1))
+	})
+	t.Run("47.952DF", func(t *testing.T) {
+		tc, err := NewTimecode(2880, 48000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:04", tc.String())
+
+		tc, err = NewTimecode(2880, 48000, 1001, func(p *TimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:00", tc.String())
+
+		tc, err = ParseTimecode("00:01:00;00", 48000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00;04", tc.String())
+		assert.Equal(t, uint64(2880), tc.Frames())
+	})
+	t.Run("95.904DF", func(t *testing.T) {
+		tc, err := NewTimecode(96*60*5-4*4, 96000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:05:00:00", tc.String())
+
+		tc, err = ParseTimecode("00:06:00:00", 96000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:06:00:04", tc.String())
+
+		maxFrames := uint64(96*86400-4*(1440-288)) - 1
+		tc, err = NewTimecode(maxFrames, 96000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "23:59:59:95", tc.String())
+		assert.Equal(t, maxFrames, tc.Frames())
+
+		for frames := uint64(0); frames <= maxFrames; frames += 997 {
+			tc, err := NewTimecode(frames, 96000, 1001)
+			assert.NoError(t, err)
+			assert.Equal(t, frames, tc.Frames())
+		}
+	})
+	t.Run("error/duplicate", func(t *testing.T) {
+		assert.Equal(t, ErrDuplicateFrameRate, RegisterFrameRate(30000, 1001, 2, 10))
+		assert.Equal(t, ErrDuplicateFrameRate, RegisterFrameRate(24, 1, 0, 0))
+	})
+	t.Run("error/invalid", func(t *testing.T) {
+		assert.Equal(t, ErrInvalidFrameRate, RegisterFrameRate(0, 1, 0, 0))
+		assert.Equal(t, ErrInvalidFrameRate, RegisterFrameRate(1, 0, 0, 0))
+		assert.Equal(t, ErrInvalidFrameRate, RegisterFrameRate(7, 1, -1, 10))
+		assert.Equal(t, ErrInvalidFrameRate, RegisterFrameRate(7, 1, 7, 10))
+		assert.Equal(t, ErrInvalidFrameRate, RegisterFrameRate(7, 1, 1, 1))
+		assert.Equal(t, ErrInvalidFrameRate, RegisterFrameRate(25, 2, 0, 0))
+		assert.Equal(t, ErrInvalidFrameRate, RegisterFrameRate(7000, 1002, 0, 0))
+		assert.False(t, IsSupportedFrameRate(25, 2))
+		assert.False(t, IsSupportedFrameRate(7, 1))
+	})
+}
+
+func TestTolerance(t *testing.T) {
+	t.Run("NewTimecode", func(t *testing.T) {
+		tc, err := NewTimecode(1800, 2997, 100, func(p *TimecodeOptionParam) {
+			p.Tolerance = 0.001
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:02", tc.String())
+		assert.Equal(t, int32(30000), tc.FramerateNumerator())
+		assert.Equal(t, int32(1001), tc.FramerateDenominator())
+	})
+	t.Run("NewTimecode/NDF", func(t *testing.T) {
+		tc, err := NewTimecode(1800, 5994, 100, func(p *TimecodeOptionParam) {
+			p.Tolerance = 0.001
+			p.PreferDF = false
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:30:00", tc.String())
+		assert.Equal(t, Rate59_94NDF, tc.FrameRate())
+	})
+	t.Run("NewTimecode/exact match is preferred", func(t *testing.T) {
+		tc, err := NewTimecode(1800, 30, 1, func(p *TimecodeOptionParam) {
+			p.Tolerance = 0.1
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, Rate30, tc.FrameRate())
+	})
+	t.Run("NewTimecode/nearest", func(t *testing.T) {
+		tc, err := NewTimecode(1800, 2998, 100, func(p *TimecodeOptionParam) {
+			p.Tolerance = 0.1
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, Rate29_97DF, tc.FrameRate())
+	})
+	t.Run("ParseTimecode", func(t *testing.T) {
+		tc, err := ParseTimecode("00:01:00;00", 2397, 100, func(p *ParseTimecodeOptionParam) {
+			p.Tolerance = 0.01
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, Rate23_976, tc.FrameRate())
+		assert.Equal(t, uint64(1440), tc.Frames())
+	})
+	t.Run("error/out of tolerance", func(t *testing.T) {
+		tc, err := NewTimecode(1800, 2997, 100, func(p *TimecodeOptionParam) {
+			p.Tolerance = 0.00001
+		})
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+
+		tc, err = ParseTimecode("00:01:00:00", 2997, 100)
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+	})
+}
+
+func TestMulDiv(t *testing.T) {
+	q, ok := mulDiv(7, 3, 2, RoundFloor)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), q)
+	q, ok = mulDiv(7, 3, 2, RoundNearest)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(11), q)
+	q, ok = mulDiv(7, 3, 2, RoundCeil)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(11), q)
+	q, ok = mulDiv(5, 1, 3, RoundNearest)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), q)
+	q, ok = mulDiv(math.MaxUint64, 1000, 1000, RoundFloor)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(math.MaxUint64), q)
+	_, ok = mulDiv(math.MaxUint64, 1000, 999, RoundFloor)
+	assert.False(t, ok)
+}
+
+func TestDuration(t *testing.T) {
+	t.Run("29.97DF/1h", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00;00", 30000, 1001)
+		assert.Equal(t, uint64(107892), tc.Frames())
+		assert.Equal(t, 3599996400*time.Microsecond, tc.Duration())
+	})
+	t.Run("29.97NDF/1h", func(t *testing.T) {
+		tc, _ := NewTimecode(108000, 30000, 1001, func(p *TimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.Equal(t, 3603600*time.Millisecond, tc.Duration())
+	})
+	t.Run("23.976/23h", func(t *testing.T) {
+		tc, _ := ParseTimecode("23:00:00:00", 24000, 1001)
+		assert.Equal(t, 23*3600*1001*time.Millisecond, tc.Duration())
+	})
+	t.Run("rounded to nanosecond", func(t *testing.T) {
+		tc, _ := NewTimecode(1, 30000, 1001)
+		assert.Equal(t, 33366667*time.Nanosecond, tc.Duration())
+	})
+}
+
+func TestFromDuration(t *testing.T) {
+	rounding := func(r Rounding) TimecodeOption {
+		return func(p *TimecodeOptionParam) {
+			p.Rounding = r
+		}
+	}
+
+	t.Run("exact", func(t *testing.T) {
+		tc, err := FromDuration(3599996400*time.Microsecond, 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", tc.String())
+		assert.Equal(t, uint64(107892), tc.Frames())
+	})
+	t.Run("floor", func(t *testing.T) {
+		tc, err := FromDuration(1500*time.Millisecond, 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(44), tc.Frames()) // 44.955
+	})
+	t.Run("nearest", func(t *testing.T) {
+		tc, err := FromDuration(1500*time.Millisecond, 30000, 1001, rounding(RoundNearest))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(45), tc.Frames())
+
+		tc, err = FromDuration(20*time.Millisecond, 25, 1, rounding(RoundNearest))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), tc.Frames()) // 0.5 frames
+	})
+	t.Run("ceil", func(t *testing.T) {
+		tc, err := FromDuration(1480*time.Millisecond, 25, 1, rounding(RoundCeil))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(37), tc.Frames())
+
+		tc, err = FromDuration(1440*time.Millisecond, 25, 1, rounding(RoundCeil))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(36), tc.Frames())
+	})
+	t.Run("round trip", func(t *testing.T) {
+		for _, fr := range []FrameRate{Rate23_976, Rate25, Rate29_97DF, Rate59_94NDF, Rate119_88DF} {
+			for frames := uint64(0); frames < 1000000; frames += 9973 {
+				tc, err := NewTimecodeWithRate(frames, fr)
+				assert.NoError(t, err)
+				tc2, err := FromDurationWithRate(tc.Duration(), fr, rounding(RoundNearest))
+				assert.NoError(t, err)
+				assert.Equal(t, frames, tc2.Frames())
+			}
+		}
+	})
+	t.Run("error/negative", func(t *testing.T) {
+		tc, err := FromDuration(-time.Second, 25, 1)
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrUnderflowFrames, err)
+	})
+	t.Run("error/too many frames", func(t *testing.T) {
+		tc, err := FromDuration(24*time.Hour, 25, 1)
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+	t.Run("error/unsupported", func(t *testing.T) {
+		tc, err := FromDuration(time.Second, 7, 1)
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrUnsupportedFrameRate, err)
+	})
+}
+
+func TestLabelDuration(t *testing.T) {
+	assumeNDF := func(p *TimecodeOptionParam) {
+		p.PreferDF = false
+	}
+
+	t.Run("29.97NDF", func(t *testing.T) {
+		tc, _ := NewTimecode(108000, 30000, 1001, assumeNDF)
+		assert.Equal(t, "01:00:00:00", tc.String())
+		assert.Equal(t, time.Hour, tc.LabelDuration())
+		assert.Equal(t, 3603600*time.Millisecond, tc.Duration())
+		assert.Equal(t, 3600*time.Millisecond, tc.Drift())
+	})
+	t.Run("29.97DF", func(t *testing.T) {
+		tc, _ := ParseTimecode("01:00:00;00", 30000, 1001)
+		assert.Equal(t, time.Hour, tc.LabelDuration())
+		assert.Equal(t, -3600*time.Microsecond, tc.Drift())
+
+		tc, _ = ParseTimecode("00:00:59;29", 30000, 1001)
+		assert.Equal(t, 59*time.Second+966666667*time.Nanosecond, tc.LabelDuration())
+		assert.Equal(t, 60026633333*time.Nanosecond, tc.Duration())
+		assert.Equal(t, 59966666*time.Nanosecond, tc.Drift())
+	})
+	t.Run("25", func(t *testing.T) {
+		tc, _ := ParseTimecode("10:00:00:12", 25, 1)
+		assert.Equal(t, 10*time.Hour+480*time.Millisecond, tc.LabelDuration())
+		assert.Equal(t, time.Duration(0), tc.Drift())
+	})
+}
+
+func TestFromRealTime(t *testing.T) {
+	t.Run("29.97NDF", func(t *testing.T) {
+		tc, err := FromRealTime(time.Hour, 30000, 1001, func(p *TimecodeOptionParam) {
+			p.PreferDF = false
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "00:59:56:12", tc.String())
+		assert.Equal(t, uint64(107892), tc.Frames())
+	})
+	t.Run("29.97DF", func(t *testing.T) {
+		tc, err := FromRealTime(time.Hour, 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, "01:00:00:00", tc.String())
+	})
+	t.Run("nearest", func(t *testing.T) {
+		tc, err := FromRealTime(1500*time.Millisecond, 30000, 1001)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(45), tc.Frames())
+	})
+	t.Run("rounding option", func(t *testing.T) {
+		tc, err := FromRealTime(1500*time.Millisecond, 30000, 1001, func(p *TimecodeOptionParam) {
+			p.Rounding = RoundFloor
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(44), tc.Frames())
+	})
+}
+
+func TestSigned(t *testing.T) {
+	signed := func(p *TimecodeOptionParam) {
+		p.Signed = true
+	}
+	parseSigned := func(p *ParseTimecodeOptionParam) {
+		p.Signed = true
+	}
+
+	t.Run("SubFrames", func(t *testing.T) {
+		tc1, _ := NewTimecode(0, 25, 1, signed)
+		tc2, err := tc1.SubFrames(125)
+		assert.NoError(t, err)
+		assert.Equal(t, "-00:00:05:00", tc2.String())
+		assert.True(t, tc2.Negative)
+		assert.Equal(t, uint64(125), tc2.Frames())
+		assert.Equal(t, int64(-125), tc2.SignedFrames())
+		assert.Equal(t, -5*time.Second, tc2.Duration())
+		assert.Equal(t, -5*time.Second, tc2.LabelDuration())
+
+		tc3, err := tc2.AddFrames(126)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:00:01", tc3.String())
+		assert.False(t, tc3.Negative)
+	})
+	t.Run("Sub", func(t *testing.T) {
+		tc1, _ := NewTimecode(1800, 30000, 1001, signed)
+		tc2, _ := NewTimecode(3600, 30000, 1001)
+		tc3, err := tc1.Sub(tc2)
+		assert.NoError(t, err)
+		assert.Equal(t, "-00:01:00:02", tc3.String())
+		assert.Equal(t, int64(-1800), tc3.SignedFrames())
+
+		tc4, err := tc3.Add(tc3)
+		assert.NoError(t, err)
+		assert.Equal(t, "-00:02:00:04", tc4.String())
+		assert.Equal(t, int64(-3600), tc4.SignedFrames())
+
+		tc5, err := tc2.Add(tc3)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:01:00:02", tc5.String())
+	})
+	t.Run("ParseTimecode", func(t *testing.T) {
+		tc, err := ParseTimecode("-00:00:05:00", 25, 1, parseSigned)
+		assert.NoError(t, err)
+		assert.Equal(t, "-00:00:05:00", tc.String())
+		assert.Equal(t, int64(-125), tc.SignedFrames())
+
+		tc, err = ParseTimecode("-00:00:00:00", 25, 1, parseSigned)
+		assert.NoError(t, err)
+		assert.Equal(t, "00:00:00:00", tc.String())
+		assert.False(t, tc.Negative)
+
+		tc, err = ParseTimecode("-00:00:05:00", 25, 1)
+		assert.Nil(t, tc)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+	})
+	t.Run("FromDuration", func(t *testing.T) {
+		tc, err := FromDuration(-1500*time.Millisecond, 30000, 1001, signed)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-45), tc.SignedFrames()) // floor of -44.955
+
+		tc, err = FromDuration(-1500*time.Millisecond, 30000, 1001, signed, func(p *TimecodeOptionParam) {
+			p.Rounding = RoundCeil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-44), tc.SignedFrames())
+	})
+	t.Run("error/unsigned", func(t *testing.T) {
+		tc1, _ := NewTimecode(0, 25, 1)
+		tc2, err := tc1.SubFrames(1)
+		assert.Nil(t, tc2)
+		assert.Equal(t, ErrUnderflowFrames, err)
+	})
+	t.Run("error/too many frames", func(t *testing.T) {
+		tc1, _ := NewTimecode(0, 25, 1, signed)
+		tc2, err := tc1.SubFrames(25 * 86400)
+		assert.Nil(t, tc2)
+		assert.Equal(t, ErrTooManyFrames, err)
+
+		tc3, err := tc1.AddFrames(math.MaxUint64)
+		assert.Nil(t, tc3)
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+	t.Run("error/overflow", func(t *testing.T) {
+		tc1, _ := NewTimecode(100, 25, 1)
+		tc2, err := tc1.AddFrames(math.MaxInt64 - 5)
+		assert.Nil(t, tc2)
+		assert.Equal(t, ErrTooManyFrames, err)
+
+		tc2, err = tc1.SubFrames(math.MaxInt64 - 5)
+		assert.Nil(t, tc2)
+		assert.Equal(t, ErrUnderflowFrames, err)
+
+		tc1, _ = NewTimecode(100, 25, 1, signed)
+		tc1, _ = tc1.SubFrames(200)
+		tc2, err = tc1.SubFrames(math.MaxInt64 - 5)
+		assert.Nil(t, tc2)
+		assert.Equal(t, ErrTooManyFrames, err)
+
+		tc1, _ = NewTimecode(100, 25, 1, signed)
+		tc2, err = tc1.AddFrames(math.MaxInt64 - 5)
+		assert.Nil(t, tc2)
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+}
+
+func TestWrap(t *testing.T) {
+	t.Run("AddFramesWrap", func(t *testing.T) {
+		tc1, _ := ParseTimecode("23:59:59;29", 30000, 1001)
+		tc2, wrapped, err := tc1.AddFramesWrap(1)
+		assert.NoError(t, err)
+		assert.True(t, wrapped)
+		assert.Equal(t, "00:00:00;00", tc2.String())
+
+		tc3, wrapped, err := tc1.AddFramesWrap(1801)
+		assert.NoError(t, err)
+		assert.True(t, wrapped)
+		assert.Equal(t, "00:01:00;02", tc3.String())
+
+		tc4, wrapped, err := tc2.AddFramesWrap(1800)
+		assert.NoError(t, err)
+		assert.False(t, wrapped)
+		assert.Equal(t, "00:01:00;02", tc4.String())
+
+		tc5, wrapped, err := tc2.AddFramesWrap(2589408 * 2)
+		assert.NoError(t, err)
+		assert.True(t, wrapped)
+		assert.Equal(t, "00:00:00;00", tc5.String())
+	})
+	t.Run("SubFramesWrap", func(t *testing.T) {
+		tc1, _ := NewTimecode(0, 25, 1)
+		tc2, wrapped, err := tc1.SubFramesWrap(1)
+		assert.NoError(t, err)
+		assert.True(t, wrapped)
+		assert.Equal(t, "23:59:59:24", tc2.String())
+
+		tc3, wrapped, err := tc2.SubFramesWrap(24)
+		assert.NoError(t, err)
+		assert.False(t, wrapped)
+		assert.Equal(t, "23:59:59:00", tc3.String())
+	})
+	t.Run("SubWrap/overnight", func(t *testing.T) {
+		start, _ := ParseTimecode("22:30:00:00", 25, 1)
+		end, _ := ParseTimecode("01:15:00:00", 25, 1)
+		d, wrapped, err := end.SubWrap(start)
+		assert.NoError(t, err)
+		assert.True(t, wrapped)
+		assert.Equal(t, "02:45:00:00", d.String())
+	})
+	t.Run("AddWrap", func(t *testing.T) {
+		tc1, _ := ParseTimecode("20:00:00:00", 24, 1)
+		tc2, _ := ParseTimecode("05:00:00:12", 24, 1)
+		tc3, wrapped, err := tc1.AddWrap(tc2)
+		assert.NoError(t, err)
+		assert.True(t, wrapped)
+		assert.Equal(t, "01:00:00:12", tc3.String())
+	})
+	t.Run("signed", func(t *testing.T) {
+		tc1, _ := NewTimecode(0, 25, 1, func(p *TimecodeOptionParam) {
+			p.Signed = true
+		})
+		tc2, _ := tc1.SubFrames(25)
+		tc3, wrapped, err := tc2.AddFramesWrap(0)
+		assert.NoError(t, err)
+		assert.True(t, wrapped)
+		assert.Equal(t, "23:59:59:00", tc3.String())
+	})
+	t.Run("error/mismatch frame rate", func(t *testing.T) {
+		tc1, _ := NewTimecode(0, 25, 1)
+		tc2, _ := NewTimecode(0, 24, 1)
+		tc3, wrapped, err := tc1.AddWrap(tc2)
+		assert.Nil(t, tc3)
+		assert.False(t, wrapped)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+
+		tc3, wrapped, err = tc1.SubWrap(tc2)
+		assert.Nil(t, tc3)
+		assert.False(t, wrapped)
+		assert.Equal(t, ErrMismatchFrameRate, err)
+	})
+}
+
+func TestExtended(t *testing.T) {
+	extended := func(p *TimecodeOptionParam) {
+		p.Extended = true
+	}
+	parseExtended := func(p *ParseTimecodeOptionParam) {
+		p.Extended = true
+	}
+
+	t.Run("NewTimecode/29.97DF", func(t *testing.T) {
+		day := uint64(2589408)
+		tc, err := NewTimecode(day, 30000, 1001, extended)
+		assert.NoError(t, err)
+		assert.Equal(t, "24:00:00:00", tc.String())
+		assert.Equal(t, day, tc.Frames())
+
+		tc, err = NewTimecode(day*3+1800, 30000, 1001, extended)
+		assert.NoError(t, err)
+		assert.Equal(t, "72:01:00:02", tc.String())
+		assert.Equal(t, day*3+1800, tc.Frames())
+
+		for frames := uint64(0); frames < day*200; frames += 1000003 {
+			tc, err := NewTimecode(frames, 30000, 1001, extended)
+			assert.NoError(t, err)
+			assert.Equal(t, frames, tc.Frames())
+		}
+
+		tc, err = NewTimecode(day, 30000, 1001)
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+	t.Run("NewTimecode/max", func(t *testing.T) {
+		maxFrames := uint64(10000*3600*25) - 1
+		tc, err := NewTimecode(maxFrames, 25, 1, extended)
+		assert.NoError(t, err)
+		assert.Equal(t, "9999:59:59:24", tc.String())
+
+		tc, err = NewTimecode(maxFrames+1, 25, 1, extended)
+		assert.Nil(t, tc)
+		assert.Equal(t, ErrTooManyFrames, err)
+	})
+	t.Run("ParseTimecode", func(t *testing.T) {
+		tc, err := ParseTimecode("100:00:00;00", 30000, 1001, parseExtended)
+		assert.NoError(t, err)
+		assert.Equal(t, "100:00:00;00", tc.String())
+		assert.Equal(t, uint64(2589408*100/24), tc.Frames())
+
+		tc, err = ParseTimecode("24:01:00;00", 30000, 1001, parseExtended)
+		assert.NoError(t, err)
+		assert.Equal(t, "24:01:00;02", tc.String())
+
+		tc, err = ParseTimecode("100:00:00;00", 30000, 1001)
+		assert.Nil(t, tc)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+
+		tc, err = ParseTimecode("10000:00:00;00", 30000, 1001, parseExtended)
+		assert.Nil(t, tc)
+		assert.ErrorIs(t, err, ErrInvalidTimecode)
+	})
+	t.Run("AddFrames", func(t *testing.T) {
+		tc1, _ := ParseTimecode("23:59:59:24", 25, 1, parseExtended)
+		tc2, err := tc1.AddFrames(1)
+		assert.NoError(t, err)
+		assert.Equal(t, "24:00:00:00", tc2.String())
+		assert.Equal(t, 24*time.Hour, tc2.Duration())
+	})
+	t.Run("IsRepresentableFrames", func(t *testing.T) {
+		assert.False(t, IsRepresentableFrames(25*86400, 25, 1))
+		assert.True(t, IsRepresentableFrames(25*86400, 25, 1, func(p *IsRepresentableFramesOptionParam) {
+			p.Extended = true
+		}))
+	})
+}